@@ -16,6 +16,21 @@ type AuthInfo struct {
 	Roles []string
 }
 
+// WriteAuthorized tells if the user may change the configuration: any user
+// when authentication is not enabled, otherwise users with the admin role,
+// as translib authorizes Set requests.
+func (a *AuthInfo) WriteAuthorized() bool {
+	if !a.AuthEnabled {
+		return true
+	}
+	for _, role := range a.Roles {
+		if role == "admin" {
+			return true
+		}
+	}
+	return false
+}
+
 // RequestContext holds metadata about REST request.
 type RequestContext struct {
 
@@ -50,3 +65,12 @@ func GetContext(ctx context.Context) (*RequestContext, context.Context) {
 	ctx = context.WithValue(ctx, requestContextKey, rc)
 	return rc, ctx
 }
+
+// DetachContext returns a context carrying the RequestContext of ctx,
+// without the deadline and cancellation of ctx. It is for the work
+// started by a request which may outlive the request, ex. a data feed
+// shared by several subscriptions.
+func DetachContext(ctx context.Context) context.Context {
+	rc, _ := GetContext(ctx)
+	return context.WithValue(context.Background(), requestContextKey, rc)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	spb "proto"
 	sdc "sonic_data_client"
+	sdcfg "sonic_db_config"
 	"github.com/go-redis/redis"
 	log "github.com/golang/glog"
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
@@ -194,7 +195,7 @@ func (cs *clientSubscription) NewInstance(ctx context.Context) error {
 	if target == "OTHERS" {
 		dc, err = sdc.NewNonDbClient(cs.paths, cs.prefix)
 	} else {
-		dc, err = sdc.NewDbClient(cs.paths, cs.prefix)
+		dc, err = sdc.NewDbClient(cs.paths, cs.prefix, ctx)
 	}
 	if err != nil {
 		log.V(1).Infof("Connection to DB for %v failed: %v", *cs, err)
@@ -350,11 +351,7 @@ restart: //Remote server might go down, in that case we restart with next destin
 				var updates []*gpb.Update
 				var spbValue *spb.Value
 				for _, spbValue = range spbValues {
-					update := &gpb.Update{
-						Path: spbValue.GetPath(),
-						Val:  spbValue.GetVal(),
-					}
-					updates = append(updates, update)
+					updates = append(updates, sdc.ValueUpdates(spbValue)...)
 				}
 				rs := &gpb.SubscribeResponse_Update{
 					Update: &gpb.Notification{
@@ -461,7 +458,7 @@ func setupDestGroupClients(ctx context.Context, destGroupName string) {
 // start/stop/update telemetry publist client as requested
 // TODO: more validation on db data
 func processTelemetryClientConfig(ctx context.Context, redisDb *redis.Client, key string, op string) error {
-	separator, _ := sdc.GetTableKeySeparator("CONFIG_DB")
+	separator, _ := sdc.GetTableKeySeparator("CONFIG_DB", sdcfg.SONIC_DEFAULT_NAMESPACE)
 	tableKey := "TELEMETRY_CLIENT" + separator + key
 	fv, err := redisDb.HGetAll(tableKey).Result()
 	if err != nil {
@@ -641,29 +638,40 @@ func processTelemetryClientConfig(ctx context.Context, redisDb *redis.Client, ke
 // read configDB data for telemetry client and start publishing service for client subscription
 func DialOutRun(ctx context.Context, ccfg *ClientConfig) error {
 	clientCfg = ccfg
-	dbn := spb.Target_value["CONFIG_DB"]
+	dbn, err := sdcfg.GetDbId("CONFIG_DB", sdcfg.SONIC_DEFAULT_NAMESPACE)
+	if err != nil {
+		return err
+	}
 
 	var redisDb *redis.Client
 	if sdc.UseRedisLocalTcpPort == false {
+		sock, err := sdcfg.GetDbSock("CONFIG_DB", sdcfg.SONIC_DEFAULT_NAMESPACE)
+		if err != nil {
+			return err
+		}
 		redisDb = redis.NewClient(&redis.Options{
 			Network:     "unix",
-			Addr:        sdc.Default_REDIS_UNIXSOCKET,
+			Addr:        sock,
 			Password:    "", // no password set
-			DB:          int(dbn),
+			DB:          dbn,
 			DialTimeout: 0,
 		})
 	} else {
+		addr, err := sdcfg.GetDbTcpAddr("CONFIG_DB", sdcfg.SONIC_DEFAULT_NAMESPACE)
+		if err != nil {
+			return err
+		}
 		redisDb = redis.NewClient(&redis.Options{
 			Network:     "tcp",
-			Addr:        sdc.Default_REDIS_LOCAL_TCP_PORT,
+			Addr:        addr,
 			Password:    "", // no password set
-			DB:          int(dbn),
+			DB:          dbn,
 			DialTimeout: 0,
 		})
 	}
 
-	separator, _ := sdc.GetTableKeySeparator("CONFIG_DB")
-	pattern := "__keyspace@" + strconv.Itoa(int(dbn)) + "__:TELEMETRY_CLIENT" + separator
+	separator, _ := sdc.GetTableKeySeparator("CONFIG_DB", sdcfg.SONIC_DEFAULT_NAMESPACE)
+	pattern := "__keyspace@" + strconv.Itoa(dbn) + "__:TELEMETRY_CLIENT" + separator
 	prefixLen := len(pattern)
 	pattern += "*"
 
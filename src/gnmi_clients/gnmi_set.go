@@ -34,7 +34,10 @@ import (
 	//"github.com/google/gnxi/utils/xpath"
 	"github.com/jipanyang/gnxi/utils/xpath"
 	"google.golang.org/grpc/metadata"
+	"github.com/golang/protobuf/proto"
 	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	spb "proto/gnoi"
 )
 
 type arrayFlags []string
@@ -57,6 +60,7 @@ var (
 	timeOut    = flag.Duration("time_out", 10*time.Second, "Timeout for the Get request, 10 seconds by default")
 	pathTarget = flag.String("xpath_target", "", "name of the target for which the path is a member")
 	jwtToken   = flag.String("jwt_token", "", "JWT Token if required")
+	commitConfirmed = flag.Duration("commit_confirmed", 0, "Roll back the set unless confirmed by gnoi_client rpc confirmCommit within this time, 0 for no rollback")
 )
 
 func buildPbUpdateList(pathValuePairs []string) []*pb.Update {
@@ -174,6 +178,20 @@ func main() {
 		Replace: replaceList,
 		Update:  updateList,
 	}
+	if *commitConfirmed > 0 {
+		msg, err := proto.Marshal(&spb.CommitConfirmed{TimeoutSeconds: uint32(commitConfirmed.Seconds())})
+		if err != nil {
+			log.Exitf("Marshaling CommitConfirmed failed: %v", err)
+		}
+		setRequest.Extension = append(setRequest.Extension, &gnmi_ext.Extension{
+			Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{
+					Id:  gnmi_ext.ExtensionID_EID_EXPERIMENTAL,
+					Msg: msg,
+				},
+			},
+		})
+	}
 
 	fmt.Println("== setRequest:")
 	utils.PrintProto(setRequest)
@@ -192,4 +210,10 @@ func main() {
 
 	fmt.Println("== setResponse:")
 	utils.PrintProto(setResponse)
+	for _, ext := range setResponse.GetExtension() {
+		cc := &spb.CommitConfirmed{}
+		if err := proto.Unmarshal(ext.GetRegisteredExt().GetMsg(), cc); err == nil && cc.CommitId != "" {
+			fmt.Printf("== commit id: %v\n", cc.CommitId)
+		}
+	}
 }
@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"github.com/google/gnxi/utils/credentials"
 	"encoding/json"
+	"strings"
 )
 
 var (
@@ -74,8 +75,27 @@ func main() {
 			refresh(sc, ctx)
         case "clearNeighbors":
             clearNeighbors(sc, ctx)
+		case "saveCounterBaseline":
+			saveCounterBaseline(sc, ctx)
+		case "deleteCounterBaseline":
+			deleteCounterBaseline(sc, ctx)
+		case "confirmCommit":
+			confirmCommit(sc, ctx)
+		case "cancelCommit":
+			cancelCommit(sc, ctx)
+		case "listPendingCommits":
+			listPendingCommits(sc, ctx)
+		case "checkpoint":
+			checkpoint(sc, ctx)
+		case "listCheckpoints":
+			listCheckpoints(sc, ctx)
+		case "diffCheckpoint":
+			diffCheckpoint(sc, ctx)
+		case "rollback":
+			rollback(sc, ctx)
 		default:
-			panic("Invalid RPC Name")
+			// Any other YANG RPC of translib, ex. -rpc sonic-tests:sum
+			action(sc, ctx)
 		}
 	default:
 		panic("Invalid Module Name")
@@ -272,3 +292,183 @@ func clearNeighbors(sc spb.SonicServiceClient, ctx context.Context) {
     }
     fmt.Println(string(respstr))
 }
+
+func saveCounterBaseline(sc spb.SonicServiceClient, ctx context.Context) {
+	fmt.Println("Sonic SaveCounterBaseline")
+	ctx = setUserCreds(ctx)
+	req := &spb.SaveCounterBaselineRequest {}
+
+	json.Unmarshal([]byte(*args), req)
+
+	resp,err := sc.SaveCounterBaseline(ctx, req)
+	if err != nil {
+		panic(err.Error())
+	}
+	respstr, err := json.Marshal(resp)
+	if err != nil {
+		panic(err.Error())
+	}
+	fmt.Println(string(respstr))
+}
+
+func deleteCounterBaseline(sc spb.SonicServiceClient, ctx context.Context) {
+	fmt.Println("Sonic DeleteCounterBaseline")
+	ctx = setUserCreds(ctx)
+	req := &spb.DeleteCounterBaselineRequest {}
+
+	json.Unmarshal([]byte(*args), req)
+
+	resp,err := sc.DeleteCounterBaseline(ctx, req)
+	if err != nil {
+		panic(err.Error())
+	}
+	respstr, err := json.Marshal(resp)
+	if err != nil {
+		panic(err.Error())
+	}
+	fmt.Println(string(respstr))
+}
+
+func confirmCommit(sc spb.SonicServiceClient, ctx context.Context) {
+	fmt.Println("Sonic ConfirmCommit")
+	ctx = setUserCreds(ctx)
+	req := &spb.ConfirmCommitRequest {}
+
+	json.Unmarshal([]byte(*args), req)
+
+	resp,err := sc.ConfirmCommit(ctx, req)
+	if err != nil {
+		panic(err.Error())
+	}
+	respstr, err := json.Marshal(resp)
+	if err != nil {
+		panic(err.Error())
+	}
+	fmt.Println(string(respstr))
+}
+
+func cancelCommit(sc spb.SonicServiceClient, ctx context.Context) {
+	fmt.Println("Sonic CancelCommit")
+	ctx = setUserCreds(ctx)
+	req := &spb.CancelCommitRequest {}
+
+	json.Unmarshal([]byte(*args), req)
+
+	resp,err := sc.CancelCommit(ctx, req)
+	if err != nil {
+		panic(err.Error())
+	}
+	respstr, err := json.Marshal(resp)
+	if err != nil {
+		panic(err.Error())
+	}
+	fmt.Println(string(respstr))
+}
+
+func listPendingCommits(sc spb.SonicServiceClient, ctx context.Context) {
+	fmt.Println("Sonic ListPendingCommits")
+	ctx = setUserCreds(ctx)
+	req := &spb.ListPendingCommitsRequest {}
+
+	json.Unmarshal([]byte(*args), req)
+
+	resp,err := sc.ListPendingCommits(ctx, req)
+	if err != nil {
+		panic(err.Error())
+	}
+	respstr, err := json.Marshal(resp)
+	if err != nil {
+		panic(err.Error())
+	}
+	fmt.Println(string(respstr))
+}
+
+func checkpoint(sc spb.SonicServiceClient, ctx context.Context) {
+	fmt.Println("Sonic Checkpoint")
+	ctx = setUserCreds(ctx)
+	req := &spb.CheckpointRequest {}
+
+	json.Unmarshal([]byte(*args), req)
+
+	resp,err := sc.Checkpoint(ctx, req)
+	if err != nil {
+		panic(err.Error())
+	}
+	respstr, err := json.Marshal(resp)
+	if err != nil {
+		panic(err.Error())
+	}
+	fmt.Println(string(respstr))
+}
+
+func listCheckpoints(sc spb.SonicServiceClient, ctx context.Context) {
+	fmt.Println("Sonic ListCheckpoints")
+	ctx = setUserCreds(ctx)
+	req := &spb.ListCheckpointsRequest {}
+
+	json.Unmarshal([]byte(*args), req)
+
+	resp,err := sc.ListCheckpoints(ctx, req)
+	if err != nil {
+		panic(err.Error())
+	}
+	respstr, err := json.Marshal(resp)
+	if err != nil {
+		panic(err.Error())
+	}
+	fmt.Println(string(respstr))
+}
+
+func diffCheckpoint(sc spb.SonicServiceClient, ctx context.Context) {
+	fmt.Println("Sonic DiffCheckpoint")
+	ctx = setUserCreds(ctx)
+	req := &spb.DiffCheckpointRequest {}
+
+	json.Unmarshal([]byte(*args), req)
+
+	resp,err := sc.DiffCheckpoint(ctx, req)
+	if err != nil {
+		panic(err.Error())
+	}
+	respstr, err := json.Marshal(resp)
+	if err != nil {
+		panic(err.Error())
+	}
+	fmt.Println(string(respstr))
+}
+
+func rollback(sc spb.SonicServiceClient, ctx context.Context) {
+	fmt.Println("Sonic Rollback")
+	ctx = setUserCreds(ctx)
+	req := &spb.RollbackRequest {}
+
+	json.Unmarshal([]byte(*args), req)
+
+	resp,err := sc.Rollback(ctx, req)
+	if err != nil {
+		panic(err.Error())
+	}
+	respstr, err := json.Marshal(resp)
+	if err != nil {
+		panic(err.Error())
+	}
+	fmt.Println(string(respstr))
+}
+
+func action(sc spb.SonicServiceClient, ctx context.Context) {
+	fmt.Println("Sonic Action", *rpc)
+	ctx = setUserCreds(ctx)
+	req := &spb.ActionRequest {
+		Path: *rpc,
+		JsonInput: *args,
+	}
+	if !strings.HasPrefix(req.Path, "/") {
+		req.Path = "/" + req.Path
+	}
+
+	resp,err := sc.Action(ctx, req)
+	if err != nil {
+		panic(err.Error())
+	}
+	fmt.Println(resp.JsonOutput)
+}
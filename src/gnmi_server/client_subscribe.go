@@ -1,17 +1,20 @@
 package gnmi_server
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net"
 	"sync"
 
 	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
 	"github.com/Workiva/go-datastructures/queue"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
 	//spb "github.com/project-arlo/sonic-telemetry/proto"
+	spb "proto"
 	sdc "sonic_data_client"
 	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
 )
@@ -22,6 +25,9 @@ type Client struct {
 	sendMsg   int64
 	recvMsg   int64
 	errors    int64
+	// Values of the session queue coalesced and dropped for a slow client
+	coalesced uint64
+	dropped   uint64
 	polled    chan struct{}
 	stop      chan struct{}
 	once      chan struct{}
@@ -31,17 +37,35 @@ type Client struct {
 	// Wait for all sub go routine to finish
 	w     sync.WaitGroup
 	fatal bool
+	// Stream subscription on feeds shared with other clients
+	cache   *subscriptionCache
+	session *cacheSession
+	// Item taken from the queue but not packed with the previous ones
+	held interface{}
 }
 
 // NewClient returns a new initialized client.
-func NewClient(addr net.Addr) *Client {
+func NewClient(addr net.Addr, cache *subscriptionCache) *Client {
 	pq := queue.NewPriorityQueue(1, false)
 	return &Client{
-		addr: addr,
-		q:    pq,
+		addr:  addr,
+		q:     pq,
+		cache: cache,
 	}
 }
 
+// newDataClient creates the data client of paths for the target of prefix
+func newDataClient(ctx context.Context, prefix *gnmipb.Path, paths []*gnmipb.Path) (sdc.Client, error) {
+	target := prefix.GetTarget()
+	if target == "OTHERS" {
+		return sdc.NewNonDbClient(paths, prefix)
+	} else if isTargetDb(target) {
+		return sdc.NewDbClient(paths, prefix, ctx)
+	}
+	/* For any other target or no target create new Transl Client. */
+	return sdc.NewTranslClient(prefix, paths, ctx)
+}
+
 // String returns the target the client is querying.
 func (c *Client) String() string {
 	return c.addr.String()
@@ -72,9 +96,9 @@ func (c *Client) populateDbPathSubscrition(sublist *gnmipb.SubscriptionList) ([]
 // SubscriptionList. Once the client is started, it will run until the stream
 // is closed or the schedule completes. For Poll queries the Run will block
 // internally after sync until a Poll request is made to the server.
-func (c *Client) Run(stream gnmipb.GNMI_SubscribeServer) (err error) {
+// ctx carries the authenticated user of the stream.
+func (c *Client) Run(ctx context.Context, stream gnmipb.GNMI_SubscribeServer) (err error) {
 	defer log.V(1).Infof("Client %s shutdown", c)
-	ctx := stream.Context()
 	if stream == nil {
 		return grpc.Errorf(codes.FailedPrecondition, "cannot start client: stream is nil")
 	}
@@ -117,27 +141,21 @@ func (c *Client) Run(stream gnmipb.GNMI_SubscribeServer) (err error) {
 	if err != nil {
 		return grpc.Errorf(codes.NotFound, "Invalid subscription path: %v %q", err, query)
 	}
+	mode := c.subscribe.GetMode()
 	var dc sdc.Client
+	if mode == gnmipb.SubscriptionList_STREAM {
+		// Data of stream subscriptions comes from feeds shared by clients
+		c.session, err = c.cache.subscribe(ctx, c.subscribe)
+	} else {
+		dc, err = newDataClient(ctx, prefix, paths)
+	}
+	if err != nil {
+		return grpc.Errorf(codes.NotFound, "%v", err)
+	}
 
-    if target == "OTHERS" {
-            dc, err = sdc.NewNonDbClient(paths, prefix)
-    } else if target == "APP_DB" || target == "CONFIG_DB" || target == "COUNTERS_DB" {
-            dc, err = sdc.NewDbClient(paths, prefix)
-    } else {
-            /* For any other target or no target create new Transl Client. */
-            dc, err = sdc.NewTranslClient(prefix, paths, ctx)
-    }
-
-    if err != nil {
-            return grpc.Errorf(codes.NotFound, "%v", err)
-    }
-
-
-	switch mode := c.subscribe.GetMode(); mode {
+	switch mode {
 	case gnmipb.SubscriptionList_STREAM:
 		c.stop = make(chan struct{}, 1)
-		c.w.Add(1)
-		go dc.StreamRun(c.q, c.stop, &c.w, c.subscribe)
 	case gnmipb.SubscriptionList_POLL:
 		c.polled = make(chan struct{}, 1)
 		c.polled <- struct{}{}
@@ -154,10 +172,13 @@ func (c *Client) Run(stream gnmipb.GNMI_SubscribeServer) (err error) {
 
 	log.V(1).Infof("Client %s running", c)
 	go c.recv(stream)
-	err = c.send(stream)
+	err = c.send(ctx, stream)
 	c.Close()
 	// Wait until all child go routines exited
 	c.w.Wait()
+	if grpc.Code(err) == codes.ResourceExhausted {
+		return err
+	}
 	return grpc.Errorf(codes.InvalidArgument, "%s", err)
 }
 
@@ -167,13 +188,20 @@ func (c *Client) Run(stream gnmipb.GNMI_SubscribeServer) (err error) {
 func (c *Client) Close() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	log.V(1).Infof("Client %s Close, sendMsg %v recvMsg %v errors %v", c, c.sendMsg, c.recvMsg, c.errors)
+	if c.session != nil {
+		c.coalesced, c.dropped = c.session.q.stats()
+	}
+	log.V(1).Infof("Client %s Close, sendMsg %v recvMsg %v errors %v coalesced %v dropped %v",
+		c, c.sendMsg, c.recvMsg, c.errors, c.coalesced, c.dropped)
 	if c.q != nil {
 		if c.q.Disposed() {
 			return
 		}
 		c.q.Dispose()
 	}
+	if c.session != nil {
+		c.cache.unsubscribe(c.session)
+	}
 	if c.stop != nil {
 		close(c.stop)
 	}
@@ -216,30 +244,123 @@ func (c *Client) recv(stream gnmipb.GNMI_SubscribeServer) {
 	log.V(1).Infof("Client %s exit from recv()", c)
 }
 
-// send runs until process Queue returns an error.
-func (c *Client) send(stream gnmipb.GNMI_SubscribeServer) error {
-	for {
-		items, err := c.q.Get(1)
-
-		if items == nil {
+// next returns the next item to send, from the session queue of stream
+// subscriptions or the client queue.
+func (c *Client) next(ctx context.Context) (interface{}, error) {
+	if item := c.held; item != nil {
+		c.held = nil
+		return item, nil
+	}
+	if c.session != nil {
+		v, err := c.session.q.next(ctx)
+		c.mu.Lock()
+		c.coalesced, c.dropped = c.session.q.stats()
+		c.mu.Unlock()
+		if err != nil {
 			log.V(1).Infof("%v", err)
-			return err
+			return nil, err
 		}
+		return sdc.Value{Value: v}, nil
+	}
+
+	items, err := c.q.Get(1)
+
+	if items == nil {
+		log.V(1).Infof("%v", err)
+		return nil, err
+	}
+	if err != nil {
+		c.errors++
+		log.V(1).Infof("%v", err)
+		return nil, fmt.Errorf("unexpected queue Gext(1): %v", err)
+	}
+	return items[0], nil
+}
+
+// tryNext returns the next item to send if any, without waiting
+func (c *Client) tryNext() interface{} {
+	if c.session != nil {
+		v, err := c.session.q.next(doneCtx)
 		if err != nil {
-			c.errors++
-			log.V(1).Infof("%v", err)
-			return fmt.Errorf("unexpected queue Gext(1): %v", err)
+			return nil
+		}
+		return sdc.Value{Value: v}
+	}
+	// The client is the only consumer of the queue
+	if c.q.Len() == 0 {
+		return nil
+	}
+	items, err := c.q.Get(1)
+	if err != nil || len(items) == 0 {
+		return nil
+	}
+	return items[0]
+}
+
+// pack packs updates of the values queued after v sharing its timestamp
+// and prefix into one value with v
+func (c *Client) pack(v sdc.Value) sdc.Value {
+	if v.GetSyncResponse() || v.GetFatal() != "" {
+		return v
+	}
+	if c.cache.flatten && c.session == nil {
+		// Values of stream subscriptions are flattened by their feeds
+		v = sdc.Value{Value: sdc.FlattenValue(v.Value)}
+	}
+	n := valueNotification(v.Value)
+	size := proto.Size(n)
+	packed := false
+	for {
+		item := c.tryNext()
+		if item == nil {
+			break
+		}
+		nv, ok := item.(sdc.Value)
+		if !ok {
+			c.held = item
+			break
+		}
+		if c.cache.flatten && c.session == nil {
+			nv = sdc.Value{Value: sdc.FlattenValue(nv.Value)}
+		}
+		nn := valueNotification(nv.Value)
+		nsize := proto.Size(nn)
+		if !packable(n, size, nv.Value, nsize) {
+			c.held = item
+			break
+		}
+		n.Update = append(n.Update, nn.Update...)
+		size += nsize
+		packed = true
+	}
+	if !packed {
+		return v
+	}
+	return sdc.Value{Value: &spb.Value{
+		Prefix:    v.GetPrefix(),
+		Timestamp: v.GetTimestamp(),
+		Updates:   n.Update,
+		Deletes:   n.Delete,
+	}}
+}
+
+// send runs until process Queue returns an error.
+func (c *Client) send(ctx context.Context, stream gnmipb.GNMI_SubscribeServer) error {
+	for {
+		item, err := c.next(ctx)
+		if err != nil {
+			return err
 		}
 
 		var resp *gnmipb.SubscribeResponse
-		switch v := items[0].(type) {
+		switch v := item.(type) {
 		case sdc.Value:
-			if resp, err = sdc.ValToResp(v); err != nil {
+			if resp, err = sdc.ValToResp(c.pack(v)); err != nil {
 				c.errors++
 				return err
 			}
 		default:
-			log.V(1).Infof("Unknown data type %v for %s in queue", items[0], c)
+			log.V(1).Infof("Unknown data type %v for %s in queue", item, c)
 			c.errors++
 		}
 
@@ -0,0 +1,299 @@
+package gnmi_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"common_utils"
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	spb "proto/gnoi"
+	sdc "sonic_data_client"
+)
+
+// A Set with a CommitConfirmed extension is applied between two snapshots
+// of CONFIG_DB, recording the changes made by the commit. Unless the
+// commit is confirmed by ConfirmCommit before its timeout, whether the
+// client is still connected or not, the server reverts these changes only,
+// failing if any entry changed by the commit has changed since, ex. by a
+// later Set. Commits are serialized for the changes recorded to be of one
+// commit, unless CONFIG_DB is changed meanwhile other than by commits.
+// Pending commits are saved under CheckpointDir, to be rolled back after
+// a restart of the server, at once if their timeout expired meanwhile.
+
+// Longest timeout of a commit-confirmed commit
+const maxCommitTimeout = time.Hour
+
+type pendingCommit struct {
+	ID   string `json:"id"`
+	User string `json:"user"`
+	// Times of the commit and of its rollback, in nanoseconds since epoch
+	Time     int64            `json:"time"`
+	Deadline int64            `json:"deadline"`
+	Delta    *sdc.ConfigDelta `json:"delta"`
+	timer    *time.Timer
+}
+
+type pendingCommits struct {
+	mu sync.Mutex
+	// Pending commits, oldest first
+	commits []*pendingCommit
+	// Number of commits made, numbering their ids
+	count uint64
+	// Take a checkpoint before a commit, return the changes made since
+	// after the commit, and revert them when rolling back the commit
+	checkpoint func() (*sdc.ConfigCheckpoint, error)
+	delta      func(cp *sdc.ConfigCheckpoint) (*sdc.ConfigDelta, error)
+	revert     func(d *sdc.ConfigDelta) error
+}
+
+func newPendingCommits() *pendingCommits {
+	return &pendingCommits{
+		checkpoint: func() (*sdc.ConfigCheckpoint, error) {
+			return sdc.TakeConfigCheckpoint("")
+		},
+		delta: func(cp *sdc.ConfigCheckpoint) (*sdc.ConfigDelta, error) {
+			return cp.Delta()
+		},
+		revert: func(d *sdc.ConfigDelta) error {
+			_, err := d.Revert()
+			return err
+		},
+	}
+}
+
+// commitConfirmed returns the CommitConfirmed extension of req, nil if none.
+// EID_EXPERIMENTAL extensions not decoding to a CommitConfirmed with a
+// timeout are other experimental extensions, and are skipped.
+func commitConfirmed(req *gnmipb.SetRequest) (*spb.CommitConfirmed, error) {
+	for _, ext := range req.GetExtension() {
+		re := ext.GetRegisteredExt()
+		if re.GetId() != gnmi_ext.ExtensionID_EID_EXPERIMENTAL {
+			continue
+		}
+		cc := &spb.CommitConfirmed{}
+		if err := proto.Unmarshal(re.GetMsg(), cc); err != nil || cc.TimeoutSeconds == 0 {
+			continue
+		}
+		if time.Duration(cc.TimeoutSeconds)*time.Second > maxCommitTimeout {
+			return nil, status.Errorf(codes.InvalidArgument, "Commit timeout of %v seconds over %v seconds",
+				cc.TimeoutSeconds, maxCommitTimeout.Seconds())
+		}
+		return cc, nil
+	}
+	return nil, nil
+}
+
+// commitExtension returns the extension of a SetResponse for commit id
+func commitExtension(id string) (*gnmi_ext.Extension, error) {
+	msg, err := proto.Marshal(&spb.CommitConfirmed{CommitId: id})
+	if err != nil {
+		return nil, err
+	}
+	return &gnmi_ext.Extension{
+		Ext: &gnmi_ext.Extension_RegisteredExt{
+			RegisteredExt: &gnmi_ext.RegisteredExtension{
+				Id:  gnmi_ext.ExtensionID_EID_EXPERIMENTAL,
+				Msg: msg,
+			},
+		},
+	}, nil
+}
+
+// commit commits changes of user by apply, to be rolled back after
+// timeout unless confirmed. It returns the id of the commit.
+func (pc *pendingCommits) commit(user string, timeout time.Duration, apply func() error) (string, error) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	cp, err := pc.checkpoint()
+	if err != nil {
+		return "", status.Errorf(codes.Unavailable, "Failed to take checkpoint of CONFIG_DB: %v", err)
+	}
+	if err = apply(); err != nil {
+		return "", err
+	}
+	d, err := pc.delta(cp)
+	if err != nil {
+		log.Errorf("Failed to record changes of commit of user %v: %v", user, err)
+		return "", status.Errorf(codes.Internal, "Set applied, but failed to record its changes to roll back: %v", err)
+	}
+	pc.count++
+	now := time.Now()
+	c := &pendingCommit{
+		ID:       fmt.Sprintf("%v-%v", now.Unix(), pc.count),
+		User:     user,
+		Time:     now.UnixNano(),
+		Deadline: now.Add(timeout).UnixNano(),
+		Delta:    d,
+	}
+	if err = saveCommit(c); err != nil {
+		// Not to be left applied by a restart
+		if rerr := pc.revert(d); rerr != nil {
+			log.Errorf("Failed to revert commit %v not saved: %v", c.ID, rerr)
+		}
+		return "", status.Errorf(codes.Internal, "Failed to save commit: %v", err)
+	}
+	pc.arm(c)
+	pc.commits = append(pc.commits, c)
+	log.V(1).Infof("Commit %v of user %v to be confirmed by %v", c.ID, user, time.Unix(0, c.Deadline))
+	return c.ID, nil
+}
+
+// arm starts the timer rolling back c at its deadline
+func (pc *pendingCommits) arm(c *pendingCommit) {
+	c.timer = time.AfterFunc(time.Until(time.Unix(0, c.Deadline)), func() {
+		log.V(1).Infof("Commit %v of user %v not confirmed in time, rolling back", c.ID, c.User)
+		pc.rollback(c.ID, nil)
+	})
+}
+
+// find returns the index of commit id. mu must be held.
+func (pc *pendingCommits) find(id string) (int, error) {
+	for i, c := range pc.commits {
+		if c.ID == id {
+			return i, nil
+		}
+	}
+	return 0, status.Errorf(codes.NotFound, "Pending commit %q not found", id)
+}
+
+// authorize checks the user of auth may confirm or cancel commit c: the
+// user of the commit or an admin. auth is nil for the server.
+func authorize(c *pendingCommit, auth *common_utils.AuthInfo) error {
+	if auth == nil || auth.User == c.User || auth.WriteAuthorized() {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "Commit %v of user %v not owned by user %v", c.ID, c.User, auth.User)
+}
+
+// remove removes commit i, which is not pending anymore. mu must be held.
+func (pc *pendingCommits) remove(i int) *pendingCommit {
+	c := pc.commits[i]
+	c.timer.Stop()
+	pc.commits = append(pc.commits[:i], pc.commits[i+1:]...)
+	if err := os.Remove(commitFile(c.ID)); err != nil && !os.IsNotExist(err) {
+		log.Errorf("Failed to remove file of commit %v: %v", c.ID, err)
+	}
+	return c
+}
+
+// confirm confirms commit id for the user of auth, the commit is not
+// rolled back anymore
+func (pc *pendingCommits) confirm(id string, auth *common_utils.AuthInfo) error {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	i, err := pc.find(id)
+	if err != nil {
+		return err
+	}
+	if err = authorize(pc.commits[i], auth); err != nil {
+		return err
+	}
+	pc.remove(i)
+	log.V(1).Infof("Commit %v confirmed", id)
+	return nil
+}
+
+// rollback rolls back commit id for the user of auth, reverting its
+// changes. The commit is not pending anymore even if reverting fails.
+func (pc *pendingCommits) rollback(id string, auth *common_utils.AuthInfo) error {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	i, err := pc.find(id)
+	if err != nil {
+		return err
+	}
+	if err = authorize(pc.commits[i], auth); err != nil {
+		return err
+	}
+	c := pc.remove(i)
+	if err = pc.revert(c.Delta); err != nil {
+		log.Errorf("Failed to roll back commit %v: %v", c.ID, err)
+		st := status.Convert(sdc.ErrorStatus(err))
+		return status.Errorf(st.Code(), "Failed to roll back commit %v: %v", c.ID, st.Message())
+	}
+	log.V(1).Infof("Rolled back commit %v of user %v", c.ID, c.User)
+	return nil
+}
+
+// list returns the pending commits, oldest first
+func (pc *pendingCommits) list() []*spb.PendingCommit {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	var commits []*spb.PendingCommit
+	for _, c := range pc.commits {
+		commits = append(commits, &spb.PendingCommit{
+			CommitId:  c.ID,
+			User:      c.User,
+			Timestamp: c.Time,
+			Deadline:  c.Deadline,
+		})
+	}
+	return commits
+}
+
+// commitFile returns the file of pending commit id
+func commitFile(id string) string {
+	return filepath.Join(sdc.CheckpointDir, "pending_commits", id+".json")
+}
+
+// saveCommit saves pending commit c to its file
+func saveCommit(c *pendingCommit) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	fileName := commitFile(c.ID)
+	if err = os.MkdirAll(filepath.Dir(fileName), 0700); err != nil {
+		return err
+	}
+	// Replace the file at once not to leave it partially written
+	tmpName := fileName + ".tmp"
+	if err = ioutil.WriteFile(tmpName, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpName, fileName)
+}
+
+// load loads the commits pending when the server stopped, to be rolled
+// back at their deadline, at once if passed
+func (pc *pendingCommits) load() {
+	fileNames, err := filepath.Glob(commitFile("*"))
+	if err != nil {
+		log.Errorf("Failed to list pending commits: %v", err)
+		return
+	}
+	var commits []*pendingCommit
+	for _, fileName := range fileNames {
+		data, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			log.Errorf("Failed to read pending commit: %v", err)
+			continue
+		}
+		c := &pendingCommit{}
+		if err = json.Unmarshal(data, c); err != nil || c.Delta == nil {
+			log.Errorf("%v: Invalid pending commit: %v", fileName, err)
+			continue
+		}
+		commits = append(commits, c)
+	}
+	sort.Slice(commits, func(i, j int) bool { return commits[i].Time < commits[j].Time })
+
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	for _, c := range commits {
+		pc.arm(c)
+		pc.commits = append(pc.commits, c)
+		log.V(1).Infof("Loaded commit %v of user %v to be confirmed by %v", c.ID, c.User, time.Unix(0, c.Deadline))
+	}
+}
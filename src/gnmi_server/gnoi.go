@@ -11,7 +11,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"encoding/json"
 	"os/user"
+	"sort"
+	"strings"
 	jwt "github.com/dgrijalva/jwt-go"
+	"common_utils"
+	sdc "sonic_data_client"
 )
 
 func (srv *Server) Reboot(ctx context.Context, req *gnoi_system_pb.RebootRequest) (*gnoi_system_pb.RebootResponse, error) {
@@ -104,7 +108,7 @@ func (srv *Server) CopyConfig(ctx context.Context, req *spb.CopyConfigRequest) (
 	jsresp, err:= transutil.TranslProcessAction("/sonic-config-mgmt:copy", []byte(reqstr), ctx)
 
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, err
 	}
 	
 	err = json.Unmarshal(jsresp, resp)
@@ -135,7 +139,7 @@ func (srv *Server) ShowTechsupport(ctx context.Context, req *spb.TechsupportRequ
 	jsresp, err:= transutil.TranslProcessAction("/sonic-show-techsupport:sonic-show-techsupport-info", []byte(reqstr), ctx)
 
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, err
 	}
 	
 	err = json.Unmarshal(jsresp, resp)
@@ -167,7 +171,7 @@ func (srv *Server) ImageInstall(ctx context.Context, req *spb.ImageInstallReques
 	jsresp, err:= transutil.TranslProcessAction("/sonic-image-management:image-install", []byte(reqstr), ctx)
 
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, err
 	}
 	
 	err = json.Unmarshal(jsresp, resp)
@@ -199,7 +203,7 @@ func (srv *Server) ImageRemove(ctx context.Context, req *spb.ImageRemoveRequest)
 	}
 	jsresp, err:= transutil.TranslProcessAction("/sonic-image-management:image-remove", []byte(reqstr), ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, err
 	}
 	
 	err = json.Unmarshal(jsresp, resp)
@@ -231,7 +235,7 @@ func (srv *Server) ImageDefault(ctx context.Context, req *spb.ImageDefaultReques
 	}
 	jsresp, err:= transutil.TranslProcessAction("/sonic-image-management:image-default", []byte(reqstr), ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, err
 	}
 
 	err = json.Unmarshal(jsresp, resp)
@@ -265,7 +269,7 @@ func (srv *Server) Sum(ctx context.Context, req *spb.SumRequest) (*spb.SumRespon
 
 	
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, err
 	}
 	
 	err = json.Unmarshal(jsresp, resp)
@@ -352,7 +356,7 @@ func (srv *Server) ClearNeighbors(ctx context.Context, req *spb.ClearNeighborsRe
     jsresp, err:= transutil.TranslProcessAction("/sonic-neighbor:clear-neighbors", []byte(reqstr), ctx)
 
     if err != nil {
-        return nil, status.Error(codes.Unknown, err.Error())
+        return nil, err
     }
 
     err = json.Unmarshal(jsresp, resp)
@@ -362,3 +366,209 @@ func (srv *Server) ClearNeighbors(ctx context.Context, req *spb.ClearNeighborsRe
 
     return resp, nil
 }
+
+func (srv *Server) SaveCounterBaseline(ctx context.Context, req *spb.SaveCounterBaselineRequest) (*spb.SaveCounterBaselineResponse, error) {
+	ctx,err := authenticate(srv.config.UserAuth, ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("gNOI: Sonic SaveCounterBaseline")
+
+	rc, _ := common_utils.GetContext(ctx)
+	n, err := sdc.SaveCounterBaseline(rc.Auth.User, req.Name, req.Namespace, req.Paths)
+	if err != nil {
+		return nil, sdc.ErrorStatus(err)
+	}
+	return &spb.SaveCounterBaselineResponse{NumKeys: uint32(n)}, nil
+}
+
+func (srv *Server) DeleteCounterBaseline(ctx context.Context, req *spb.DeleteCounterBaselineRequest) (*spb.DeleteCounterBaselineResponse, error) {
+	ctx,err := authenticate(srv.config.UserAuth, ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("gNOI: Sonic DeleteCounterBaseline")
+
+	rc, _ := common_utils.GetContext(ctx)
+	if err = sdc.DeleteCounterBaseline(rc.Auth.User, req.Name); err != nil {
+		return nil, sdc.ErrorStatus(err)
+	}
+	return &spb.DeleteCounterBaselineResponse{}, nil
+}
+
+func (srv *Server) ConfirmCommit(ctx context.Context, req *spb.ConfirmCommitRequest) (*spb.ConfirmCommitResponse, error) {
+	ctx,err := authenticate(srv.config.UserAuth, ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("gNOI: Sonic ConfirmCommit")
+
+	rc, _ := common_utils.GetContext(ctx)
+	if err = srv.commits.confirm(req.CommitId, &rc.Auth); err != nil {
+		return nil, err
+	}
+	return &spb.ConfirmCommitResponse{}, nil
+}
+
+func (srv *Server) CancelCommit(ctx context.Context, req *spb.CancelCommitRequest) (*spb.CancelCommitResponse, error) {
+	ctx,err := authenticate(srv.config.UserAuth, ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("gNOI: Sonic CancelCommit")
+
+	rc, _ := common_utils.GetContext(ctx)
+	if err = srv.commits.rollback(req.CommitId, &rc.Auth); err != nil {
+		return nil, err
+	}
+	return &spb.CancelCommitResponse{}, nil
+}
+
+func (srv *Server) ListPendingCommits(ctx context.Context, req *spb.ListPendingCommitsRequest) (*spb.ListPendingCommitsResponse, error) {
+	ctx,err := authenticate(srv.config.UserAuth, ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("gNOI: Sonic ListPendingCommits")
+
+	return &spb.ListPendingCommitsResponse{Commits: srv.commits.list()}, nil
+}
+
+// authorizeWrite checks the user of ctx may change the configuration
+func authorizeWrite(ctx context.Context) error {
+	rc, _ := common_utils.GetContext(ctx)
+	if !rc.Auth.WriteAuthorized() {
+		return status.Errorf(codes.PermissionDenied, "User %v not authorized to change the configuration", rc.Auth.User)
+	}
+	return nil
+}
+
+func (srv *Server) Checkpoint(ctx context.Context, req *spb.CheckpointRequest) (*spb.CheckpointResponse, error) {
+	ctx,err := authenticate(srv.config.UserAuth, ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("gNOI: Sonic Checkpoint")
+
+	if err = authorizeWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	cp, err := sdc.SaveConfigCheckpoint(req.Name, req.Namespace)
+	if err != nil {
+		return nil, sdc.ErrorStatus(err)
+	}
+	return &spb.CheckpointResponse{Timestamp: cp.Timestamp, NumEntries: uint32(len(cp.Entries))}, nil
+}
+
+func (srv *Server) ListCheckpoints(ctx context.Context, req *spb.ListCheckpointsRequest) (*spb.ListCheckpointsResponse, error) {
+	ctx,err := authenticate(srv.config.UserAuth, ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("gNOI: Sonic ListCheckpoints")
+
+	checkpoints, err := sdc.ListConfigCheckpoints()
+	if err != nil {
+		return nil, sdc.ErrorStatus(err)
+	}
+	var names []string
+	for name := range checkpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	resp := &spb.ListCheckpointsResponse{}
+	for _, name := range names {
+		cp := checkpoints[name]
+		resp.Checkpoints = append(resp.Checkpoints, &spb.CheckpointInfo{
+			Name:       name,
+			Namespace:  cp.Namespace,
+			Timestamp:  cp.Timestamp,
+			NumEntries: uint32(len(cp.Entries)),
+		})
+	}
+	return resp, nil
+}
+
+func (srv *Server) DiffCheckpoint(ctx context.Context, req *spb.DiffCheckpointRequest) (*spb.DiffCheckpointResponse, error) {
+	ctx,err := authenticate(srv.config.UserAuth, ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("gNOI: Sonic DiffCheckpoint")
+
+	cp, err := sdc.LoadConfigCheckpoint(req.Name)
+	if err != nil {
+		return nil, sdc.ErrorStatus(err)
+	}
+	diffs, err := cp.Diff()
+	if err != nil {
+		return nil, sdc.ErrorStatus(err)
+	}
+	resp := &spb.DiffCheckpointResponse{}
+	for _, d := range diffs {
+		entry := &spb.CheckpointDiff{
+			Table:  d.Table,
+			Key:    d.Key,
+			Change: spb.CheckpointDiff_Change(d.Change),
+		}
+		for _, f := range d.Fields {
+			entry.Fields = append(entry.Fields, &spb.CheckpointDiff_Field{
+				Field:           f.Field,
+				Change:          spb.CheckpointDiff_Change(f.Change),
+				CheckpointValue: f.Checkpoint,
+				CurrentValue:    f.Current,
+			})
+		}
+		resp.Entries = append(resp.Entries, entry)
+	}
+	return resp, nil
+}
+
+func (srv *Server) Rollback(ctx context.Context, req *spb.RollbackRequest) (*spb.RollbackResponse, error) {
+	ctx,err := authenticate(srv.config.UserAuth, ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("gNOI: Sonic Rollback")
+
+	if err = authorizeWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	cp, err := sdc.LoadConfigCheckpoint(req.Name)
+	if err != nil {
+		return nil, sdc.ErrorStatus(err)
+	}
+	keys, err := cp.Restore()
+	if err != nil {
+		return nil, sdc.ErrorStatus(err)
+	}
+	return &spb.RollbackResponse{NumEntries: uint32(len(keys))}, nil
+}
+
+func (srv *Server) Action(ctx context.Context, req *spb.ActionRequest) (*spb.ActionResponse, error) {
+	ctx,err := authenticate(srv.config.UserAuth, ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("gNOI: Sonic Action ", req.Path)
+
+	if !strings.HasPrefix(req.Path, "/") || !strings.Contains(req.Path, ":") {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid RPC path %q, expecting /<module>:<rpc>", req.Path)
+	}
+	input := []byte(req.JsonInput)
+	if len(input) == 0 {
+		input = []byte("{}")
+	}
+	if !json.Valid(input) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid JSON input of %v", req.Path)
+	}
+
+	// Authorization of the user for the RPC is checked by translib
+	jsresp, err := transutil.TranslProcessAction(req.Path, input, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &spb.ActionResponse{JsonOutput: string(jsresp)}, nil
+}
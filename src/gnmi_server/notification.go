@@ -0,0 +1,56 @@
+package gnmi_server
+
+import (
+	"github.com/golang/protobuf/proto"
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+	spb "proto"
+	sdc "sonic_data_client"
+)
+
+// Updates of values sharing timestamp and prefix are packed into one
+// notification, of at most maxNotificationSize bytes unless a single value
+// is larger. It is well under the 4MB default message size of gRPC
+// clients. Values with deletes are not packed after other values, as
+// deletes of a notification apply before its updates.
+const maxNotificationSize = 1 << 20
+
+// valueNotification returns the notification of updates and deletes of v
+func valueNotification(v *spb.Value) *gnmipb.Notification {
+	return &gnmipb.Notification{
+		Timestamp: v.GetTimestamp(),
+		Prefix:    v.GetPrefix(),
+		Update:    sdc.ValueUpdates(v),
+		Delete:    v.GetDeletes(),
+	}
+}
+
+// packable tells whether updates of v, of size vsize, may be packed into
+// notification n of size bytes
+func packable(n *gnmipb.Notification, size int, v *spb.Value, vsize int) bool {
+	return n != nil && !v.GetSyncResponse() && v.GetFatal() == "" &&
+		len(v.GetDeletes()) == 0 &&
+		v.GetTimestamp() == n.GetTimestamp() &&
+		proto.Equal(v.GetPrefix(), n.GetPrefix()) &&
+		size+vsize <= maxNotificationSize
+}
+
+// packNotifications returns notifications of updates of values, packing
+// the ones of consecutive values sharing timestamp and prefix.
+func packNotifications(values []*spb.Value) []*gnmipb.Notification {
+	var notifications []*gnmipb.Notification
+	var n *gnmipb.Notification
+	var size int
+	for _, v := range values {
+		vn := valueNotification(v)
+		vsize := proto.Size(vn)
+		if !packable(n, size, v, vsize) {
+			n = vn
+			notifications = append(notifications, n)
+			size = vsize
+			continue
+		}
+		n.Update = append(n.Update, vn.Update...)
+		size += vsize
+	}
+	return notifications
+}
@@ -6,6 +6,7 @@ import (
 	"net"
 	"strings"
 	"sync"
+	"time"
 	"common_utils"
 	log "github.com/golang/glog"
 	"golang.org/x/net/context"
@@ -18,6 +19,7 @@ import (
 	gnoi_system_pb "github.com/openconfig/gnoi/system"
 	sdc "sonic_data_client"
 	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
 	spb "proto/gnoi"
 	"bytes"
 )
@@ -35,6 +37,10 @@ type Server struct {
 	config  *Config
 	cMu     sync.Mutex
 	clients map[string]*Client
+	// Data feeds shared by stream subscriptions and Get
+	cache *subscriptionCache
+	// Commit-confirmed commits not confirmed yet
+	commits *pendingCommits
 }
 type AuthTypes map[string]bool
 // Config is a collection of values for Server
@@ -43,6 +49,12 @@ type Config struct {
 	// for this Server.
 	Port int64
 	UserAuth AuthTypes
+	// Values queued per stream subscription before applying
+	// SlowConsumerPolicy, 0 for unbounded
+	QueueSize          int
+	SlowConsumerPolicy SlowConsumerPolicy
+	// Send JSON values as one update per leaf
+	FlattenJSON bool
 }
 
 func (i AuthTypes) String() string {
@@ -122,7 +134,10 @@ func NewServer(config *Config, opts []grpc.ServerOption) (*Server, error) {
 		s:       s,
 		config:  config,
 		clients: map[string]*Client{},
+		cache:   newSubscriptionCache(config),
+		commits: newPendingCommits(),
 	}
+	srv.commits.load()
 	var err error
 	if srv.config.Port < 0 {
 		srv.config.Port = 0
@@ -220,7 +235,7 @@ func (srv *Server) Subscribe(stream gnmipb.GNMI_SubscribeServer) error {
 	}
 	*/
 
-	c := NewClient(pr.Addr)
+	c := NewClient(pr.Addr, srv.cache)
 
 	srv.cMu.Lock()
 	if oc, ok := srv.clients[c.String()]; ok {
@@ -231,7 +246,7 @@ func (srv *Server) Subscribe(stream gnmipb.GNMI_SubscribeServer) error {
 	srv.clients[c.String()] = c
 	srv.cMu.Unlock()
 
-	err = c.Run(stream)
+	err = c.Run(ctx, stream)
 	srv.cMu.Lock()
 	delete(srv.clients, c.String())
 	srv.cMu.Unlock()
@@ -277,43 +292,53 @@ func (srv *Server) Get(ctx context.Context, req *gnmipb.GetRequest) (*gnmipb.Get
         target = prefix.GetTarget()
 	log.V(5).Infof("GetRequest paths: %v", paths)
 
+	if notifications, ok := srv.cachedGet(ctx, prefix, paths); ok {
+		return &gnmipb.GetResponse{Notification: notifications}, nil
+	}
+
 	var dc sdc.Client
 
 	if target == "OTHERS" {
 		dc, err = sdc.NewNonDbClient(paths, prefix)
 	} else if isTargetDb(target) == true {
-		dc, err = sdc.NewDbClient(paths, prefix)
+		dc, err = sdc.NewDbClient(paths, prefix, ctx)
 	} else {
 		/* If no prefix target is specified create new Transl Data Client . */
 		dc, err = sdc.NewTranslClient(prefix, paths, ctx)
 	}
 
 	if err != nil {
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, sdc.ErrorStatus(err)
 	}
-	notifications := make([]*gnmipb.Notification, len(paths))
 	spbValues, err := dc.Get(nil)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, sdc.ErrorStatus(err)
 	}
-
-	for index, spbValue := range spbValues {
-		update := &gnmipb.Update{
-			Path: spbValue.GetPath(),
-			Val:  spbValue.GetVal(),
+	if srv.config.FlattenJSON {
+		for i, v := range spbValues {
+			spbValues[i] = sdc.FlattenValue(v)
 		}
+	}
+	return &gnmipb.GetResponse{Notification: packNotifications(spbValues)}, nil
+}
 
-		notifications[index] = &gnmipb.Notification{
-			Timestamp: spbValue.GetTimestamp(),
-			Prefix:    prefix,
-			Update:    []*gnmipb.Update{update},
+// cachedGet returns notifications of paths from the subscription cache if
+// all of them are cached.
+func (srv *Server) cachedGet(ctx context.Context, prefix *gnmipb.Path, paths []*gnmipb.Path) ([]*gnmipb.Notification, bool) {
+	var notifications []*gnmipb.Notification
+	for _, path := range paths {
+		values, ok := srv.cache.get(pathKey(ctx, prefix, path))
+		if !ok {
+			return nil, false
 		}
-		index++
+		notifications = append(notifications, packNotifications(values)...)
 	}
-	return &gnmipb.GetResponse{Notification: notifications}, nil
+	log.V(5).Infof("GetRequest served from cache: %v", paths)
+	return notifications, true
 }
 
-// Set method is not implemented. Refer to gnxi for examples with openconfig integration
+// Set implements the Set RPC in gNMI spec, setting all operations of the
+// request at once.
 func (srv *Server) Set(ctx context.Context,req *gnmipb.SetRequest) (*gnmipb.SetResponse, error) {
 	ctx, err := authenticate(srv.config.UserAuth, ctx)
 	if err != nil {
@@ -328,67 +353,62 @@ func (srv *Server) Set(ctx context.Context,req *gnmipb.SetRequest) (*gnmipb.SetR
            /* Create Transl client. */
 	dc, _ := sdc.NewTranslClient(prefix, nil, ctx)
 
-	/* DELETE */
-	for _, path := range req.GetDelete() {
-		log.V(2).Infof("Delete path: %v", path)
-
-		err := dc.Set(path, nil, sdc.DELETE)
+	/* All operations are set in one transaction, deletes first, then
+	replaces and updates. */
+	log.V(2).Infof("Set deletes: %v replaces: %v updates: %v", req.GetDelete(), req.GetReplace(), req.GetUpdate())
+	apply := func() error {
+		return dc.Set(req.GetDelete(), req.GetReplace(), req.GetUpdate())
+	}
 
+	/* A commit-confirmed commit is rolled back unless confirmed in time. */
+	cc, err := commitConfirmed(req)
+	if err != nil {
+		return nil, err
+	}
+	var extensions []*gnmi_ext.Extension
+	if cc != nil {
+		rc, _ := common_utils.GetContext(ctx)
+		id, err := srv.commits.commit(rc.Auth.User, time.Duration(cc.TimeoutSeconds)*time.Second, apply)
 		if err != nil {
 			return nil, err
 		}
+		ext, err := commitExtension(id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		extensions = append(extensions, ext)
+	} else if err = apply(); err != nil {
+		return nil, err
+	}
 
-		res := gnmipb.UpdateResult{
-						Path: path,
-      						Op:   gnmipb.UpdateResult_DELETE,
- 			    		          }
-
-		/* Add to Set response results. */
- 			results = append(results, &res)
-
+	/* DELETE */
+	for _, path := range req.GetDelete() {
+		results = append(results, &gnmipb.UpdateResult{
+			Path: path,
+			Op:   gnmipb.UpdateResult_DELETE,
+		})
 	}
 
 	/* REPLACE */
-	for _, path := range req.GetReplace(){
-		log.V(2).Infof("Replace path: %v ", path)
-
-		err = dc.Set(path.GetPath(), path.GetVal(), sdc.REPLACE)
-
-		if err != nil {
-			return nil, err
-		}
-		res := gnmipb.UpdateResult{
-						Path: path.GetPath(),
-      						Op:   gnmipb.UpdateResult_REPLACE,
-				                  }
-		/* Add to Set response results. */
- 			results = append(results, &res)
+	for _, path := range req.GetReplace() {
+		results = append(results, &gnmipb.UpdateResult{
+			Path: path.GetPath(),
+			Op:   gnmipb.UpdateResult_REPLACE,
+		})
 	}
 
-
 	/* UPDATE */
-	for _, path := range req.GetUpdate(){
-		log.V(2).Infof("Update path: %v ", path)
-
-		err = dc.Set(path.GetPath(), path.GetVal(), sdc.UPDATE)
-
-		if err != nil {
-			return nil, err
-		}
-
-		res := gnmipb.UpdateResult{
-						Path: path.GetPath(),
-      						Op:   gnmipb.UpdateResult_UPDATE,
- 					          }
-		/* Add to Set response results. */
- 			results = append(results, &res)
+	for _, path := range req.GetUpdate() {
+		results = append(results, &gnmipb.UpdateResult{
+			Path: path.GetPath(),
+			Op:   gnmipb.UpdateResult_UPDATE,
+		})
 	}
 
-
-
 	return &gnmipb.SetResponse{
  					Prefix:   req.GetPrefix(),
 		  			Response: results,
+					Extension: extensions,
 				  }, nil
 
 }
@@ -418,18 +438,7 @@ func (srv *Server) Capabilities(ctx context.Context, req *gnmipb.CapabilityReque
 					  GNMIVersion: "0.7.0"}, nil
 }
 
-func  isTargetDb ( target string) (bool) {
-	isDbClient := false 
-	dbTargetSupported := []string { "APPL_DB", "ASIC_DB" , "COUNTERS_DB", "LOGLEVEL_DB", "CONFIG_DB", "PFC_WD_DB", "FLEX_COUNTER_DB", "STATE_DB"}
-
-	    for _, name := range  dbTargetSupported {
-		    if  target ==  name {
-			    isDbClient = true
-				    return isDbClient
-		    }
-	    }
-
-	    return isDbClient
+func isTargetDb(target string) bool {
+	// Targets are the databases described in SONiC database config
+	return sdc.IsTargetDb(target)
 }
-
-
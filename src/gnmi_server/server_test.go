@@ -13,16 +13,22 @@ import (
     "github.com/kylelemons/godebug/pretty"
     "github.com/openconfig/gnmi/client"
     pb "github.com/openconfig/gnmi/proto/gnmi"
+    "github.com/openconfig/gnmi/proto/gnmi_ext"
     "github.com/openconfig/gnmi/value"
     "golang.org/x/net/context"
     "google.golang.org/grpc"
     "google.golang.org/grpc/codes"
     "google.golang.org/grpc/credentials"
+    "google.golang.org/grpc/metadata"
+    "google.golang.org/genproto/googleapis/rpc/errdetails"
     "google.golang.org/grpc/status"
     "io/ioutil"
+    "net"
     "os"
     "os/exec"
     // "reflect"
+    "strconv"
+    "strings"
     "testing"
     "time"
     "fmt"
@@ -31,6 +37,10 @@ import (
     spb "proto"
     sgpb "proto/gnoi"
     sdc "sonic_data_client"
+    transutil "transl_utils"
+    "translib/tlerr"
+    "cvl"
+    "common_utils"
     gclient "github.com/jipanyang/gnmi/client/gnmi"
     "github.com/jipanyang/gnxi/utils/xpath"
     gnoi_system_pb "github.com/openconfig/gnoi/system"
@@ -306,6 +316,22 @@ func prepareConfigDb(t *testing.T) {
     }
     mpi_pfcwd_map := loadConfig(t, "", configPfcwdByte)
     loadConfigDB(t, rclient, mpi_pfcwd_map)
+
+    fileName = "testdata/CONFIG_PORTCHANNEL_MEMBER.txt"
+    configLagMemberByte, err := ioutil.ReadFile(fileName)
+    if err != nil {
+        t.Fatalf("read file %v err: %v", fileName, err)
+    }
+    mpi_lag_member_map := loadConfig(t, "", configLagMemberByte)
+    loadConfigDB(t, rclient, mpi_lag_member_map)
+
+    fileName = "testdata/CONFIG_ACL_RULE.txt"
+    configAclRuleByte, err := ioutil.ReadFile(fileName)
+    if err != nil {
+        t.Fatalf("read file %v err: %v", fileName, err)
+    }
+    mpi_acl_rule_map := loadConfig(t, "", configAclRuleByte)
+    loadConfigDB(t, rclient, mpi_acl_rule_map)
 }
 
 func prepareDb(t *testing.T) {
@@ -390,6 +416,49 @@ func prepareDb(t *testing.T) {
     mpi_counter = loadConfig(t, "COUNTERS:oid:0x1500000000091f", countersEeth68_4Byte)
     loadDB(t, rclient, mpi_counter)
 
+    fileName = "testdata/COUNTERS_PG_NAME_MAP.txt"
+    countersPgNameMapByte, err := ioutil.ReadFile(fileName)
+    if err != nil {
+        t.Fatalf("read file %v err: %v", fileName, err)
+    }
+    mpi_pgname_map := loadConfig(t, "COUNTERS_PG_NAME_MAP", countersPgNameMapByte)
+    loadDB(t, rclient, mpi_pgname_map)
+
+    fileName = "testdata/COUNTERS_BUFFER_POOL_NAME_MAP.txt"
+    countersBufferPoolNameMapByte, err := ioutil.ReadFile(fileName)
+    if err != nil {
+        t.Fatalf("read file %v err: %v", fileName, err)
+    }
+    mpi_poolname_map := loadConfig(t, "COUNTERS_BUFFER_POOL_NAME_MAP", countersBufferPoolNameMapByte)
+    loadDB(t, rclient, mpi_poolname_map)
+
+    // Counters of priority group "Ethernet68:3" and watermarks of it, queue "Ethernet68:3"
+    // and buffer pool "ingress_lossless_pool"
+    fileName = "testdata/COUNTERS_WATERMARKS.txt"
+    countersWatermarksByte, err := ioutil.ReadFile(fileName)
+    if err != nil {
+        t.Fatalf("read file %v err: %v", fileName, err)
+    }
+    mpi_watermarks := loadConfig(t, "", countersWatermarksByte)
+    loadDB(t, rclient, mpi_watermarks)
+
+    fileName = "testdata/COUNTERS_RIF_NAME_MAP.txt"
+    countersRifNameMapByte, err := ioutil.ReadFile(fileName)
+    if err != nil {
+        t.Fatalf("read file %v err: %v", fileName, err)
+    }
+    mpi_rifname_map := loadConfig(t, "COUNTERS_RIF_NAME_MAP", countersRifNameMapByte)
+    loadDB(t, rclient, mpi_rifname_map)
+
+    // Counters of router interfaces and ACL rules
+    fileName = "testdata/COUNTERS_RIF_ACL.txt"
+    countersRifAclByte, err := ioutil.ReadFile(fileName)
+    if err != nil {
+        t.Fatalf("read file %v err: %v", fileName, err)
+    }
+    mpi_rif_acl := loadConfig(t, "", countersRifAclByte)
+    loadDB(t, rclient, mpi_rif_acl)
+
     // Load CONFIG_DB for alias translation
     prepareConfigDb(t)
 }
@@ -505,6 +574,233 @@ func TestGnmiGetSet(t *testing.T) {
     s.s.Stop()
 }
 
+// TestGnmiSetReplace shows REPLACE removing leaves not in its value,
+// unlike UPDATE merging them
+func TestGnmiSetReplace(t *testing.T) {
+    s := createServer(t, 8081)
+    go runServer(t, s)
+    defer s.s.Stop()
+
+    tlsConfig := &tls.Config{InsecureSkipVerify: true}
+    opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+    targetAddr := "127.0.0.1:8081"
+    conn, err := grpc.Dial(targetAddr, opts...)
+    if err != nil {
+        t.Fatalf("Dialing to %q failed: %v", targetAddr, err)
+    }
+    defer conn.Close()
+    gClient := pb.NewGNMIClient(conn)
+    ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+    defer cancel()
+
+    configPath := `elem: <name: "openconfig-interfaces:interfaces" > elem: <name: "interface" key: <key: "name" value: "Ethernet0" > > elem: <name: "config" >`
+    // getConfig returns the config of Ethernet0
+    getConfig := func(t *testing.T) map[string]interface{} {
+        var pbPath pb.Path
+        if err := proto.UnmarshalText(configPath, &pbPath); err != nil {
+            t.Fatalf("error in unmarshaling path: %v", err)
+        }
+        resp, err := gClient.Get(ctx, &pb.GetRequest{Path: []*pb.Path{&pbPath}, Encoding: pb.Encoding_JSON_IETF})
+        if err != nil {
+            t.Fatalf("Get failed: %v", err)
+        }
+        var val map[string]map[string]interface{}
+        jv := resp.GetNotification()[0].GetUpdate()[0].GetVal().GetJsonIetfVal()
+        if err := json.Unmarshal(jv, &val); err != nil {
+            t.Fatalf("invalid JSON %s: %v", jv, err)
+        }
+        return val["openconfig-interfaces:config"]
+    }
+    set := func(t *testing.T, op op_t, config string) {
+        runTestSet(t, ctx, gClient, &Operation{
+            textPbPath:    configPath,
+            wantRetCode:   codes.OK,
+            attributeData: `{"openconfig-interfaces:config": ` + config + `}`,
+            operation:     op,
+        })
+    }
+
+    set(t, Update, `{"name": "Ethernet0", "mtu": 9100, "description": "uplink"}`)
+
+    t.Run("update merges", func(t *testing.T) {
+        set(t, Update, `{"name": "Ethernet0", "mtu": 9000}`)
+        config := getConfig(t)
+        if config["description"] != "uplink" || config["mtu"] != float64(9000) {
+            t.Errorf("got config %v, want description uplink kept and mtu 9000", config)
+        }
+    })
+    t.Run("replace removes leaves not set", func(t *testing.T) {
+        set(t, Replace, `{"name": "Ethernet0", "mtu": 9100}`)
+        config := getConfig(t)
+        if _, ok := config["description"]; ok || config["mtu"] != float64(9100) {
+            t.Errorf("got config %v, want no description and mtu 9100", config)
+        }
+    })
+}
+
+// TestGnmiSetScalar sets leaves by scalar values instead of JSON
+func TestGnmiSetScalar(t *testing.T) {
+    s := createServer(t, 8081)
+    go runServer(t, s)
+    defer s.s.Stop()
+
+    tlsConfig := &tls.Config{InsecureSkipVerify: true}
+    opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+    targetAddr := "127.0.0.1:8081"
+    conn, err := grpc.Dial(targetAddr, opts...)
+    if err != nil {
+        t.Fatalf("Dialing to %q failed: %v", targetAddr, err)
+    }
+    defer conn.Close()
+    gClient := pb.NewGNMIClient(conn)
+    ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+    defer cancel()
+
+    configPath := func(leaf string) *pb.Path {
+        return &pb.Path{Elem: []*pb.PathElem{
+            {Name: "openconfig-interfaces:interfaces"},
+            {Name: "interface", Key: map[string]string{"name": "Ethernet0"}},
+            {Name: "config"},
+            {Name: leaf},
+        }}
+    }
+    req := &pb.SetRequest{Update: []*pb.Update{
+        {Path: configPath("mtu"), Val: &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: 9100}}},
+        {Path: configPath("description"), Val: &pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: "scalar"}}},
+        {Path: configPath("enabled"), Val: &pb.TypedValue{Value: &pb.TypedValue_BoolVal{BoolVal: true}}},
+    }}
+    if _, err := gClient.Set(ctx, req); err != nil {
+        t.Fatalf("Set of scalar values failed: %v", err)
+    }
+
+    want := map[string]string{
+        "mtu":         `{"openconfig-interfaces:mtu":9100}`,
+        "description": `{"openconfig-interfaces:description":"scalar"}`,
+        "enabled":     `{"openconfig-interfaces:enabled":true}`,
+    }
+    for leaf, wantVal := range want {
+        resp, err := gClient.Get(ctx, &pb.GetRequest{Path: []*pb.Path{configPath(leaf)}, Encoding: pb.Encoding_JSON_IETF})
+        if err != nil {
+            t.Fatalf("Get of %v failed: %v", leaf, err)
+        }
+        got := string(resp.GetNotification()[0].GetUpdate()[0].GetVal().GetJsonIetfVal())
+        if got != wantVal {
+            t.Errorf("got %v, want %v", got, wantVal)
+        }
+    }
+
+    t.Run("invalid value", func(t *testing.T) {
+        req := &pb.SetRequest{Update: []*pb.Update{
+            {Path: configPath("mtu"), Val: &pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: "jumbo"}}},
+        }}
+        if _, err := gClient.Set(ctx, req); status.Code(err) != codes.InvalidArgument {
+            t.Errorf("got %v, want InvalidArgument", err)
+        }
+    })
+}
+
+// TestGnmiSetBulk sets operations depending on each other in one request,
+// and shows a request failing on any of them leaving nothing applied
+func TestGnmiSetBulk(t *testing.T) {
+    s := createServer(t, 8081)
+    go runServer(t, s)
+    defer s.s.Stop()
+
+    tlsConfig := &tls.Config{InsecureSkipVerify: true}
+    opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+    targetAddr := "127.0.0.1:8081"
+    conn, err := grpc.Dial(targetAddr, opts...)
+    if err != nil {
+        t.Fatalf("Dialing to %q failed: %v", targetAddr, err)
+    }
+    defer conn.Close()
+    gClient := pb.NewGNMIClient(conn)
+    ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+    defer cancel()
+
+    vlanPath := func(vlan string) *pb.Path {
+        return &pb.Path{Elem: []*pb.PathElem{
+            {Name: "sonic-vlan:sonic-vlan"},
+            {Name: "VLAN"},
+            {Name: "VLAN_LIST", Key: map[string]string{"name": vlan}},
+        }}
+    }
+    memberPath := func(vlan, port string) *pb.Path {
+        return &pb.Path{Elem: []*pb.PathElem{
+            {Name: "sonic-vlan:sonic-vlan"},
+            {Name: "VLAN_MEMBER"},
+            {Name: "VLAN_MEMBER_LIST", Key: map[string]string{"name": vlan, "ifname": port}},
+        }}
+    }
+    jsonVal := func(data string) *pb.TypedValue {
+        return &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(data)}}
+    }
+    vlan := func(vlan string, id int) *pb.Update {
+        return &pb.Update{
+            Path: vlanPath(vlan),
+            Val:  jsonVal(fmt.Sprintf(`{"sonic-vlan:VLAN_LIST": [{"name": %q, "vlanid": %d}]}`, vlan, id)),
+        }
+    }
+    member := func(vlan, port string) *pb.Update {
+        return &pb.Update{
+            Path: memberPath(vlan, port),
+            Val: jsonVal(fmt.Sprintf(`{"sonic-vlan:VLAN_MEMBER_LIST": [{"name": %q, "ifname": %q, "tagging_mode": "tagged"}]}`,
+                vlan, port)),
+        }
+    }
+    get := func(path *pb.Path) error {
+        _, err := gClient.Get(ctx, &pb.GetRequest{Path: []*pb.Path{path}, Encoding: pb.Encoding_JSON_IETF})
+        return err
+    }
+
+    t.Run("VlanWithMembers", func(t *testing.T) {
+        req := &pb.SetRequest{Update: []*pb.Update{
+            vlan("Vlan100", 100),
+            member("Vlan100", "Ethernet0"),
+            member("Vlan100", "Ethernet4"),
+        }}
+        if _, err := gClient.Set(ctx, req); err != nil {
+            t.Fatalf("Set of VLAN with members failed: %v", err)
+        }
+        for _, path := range []*pb.Path{vlanPath("Vlan100"), memberPath("Vlan100", "Ethernet0"), memberPath("Vlan100", "Ethernet4")} {
+            if err := get(path); err != nil {
+                t.Errorf("Get of %v failed: %v", path, err)
+            }
+        }
+    })
+
+    t.Run("FailingOpAppliesNothing", func(t *testing.T) {
+        // Member of a VLAN not existing fails validation
+        req := &pb.SetRequest{Update: []*pb.Update{
+            vlan("Vlan200", 200),
+            member("Vlan200", "Ethernet8"),
+            member("Vlan300", "Ethernet12"),
+        }}
+        if _, err := gClient.Set(ctx, req); err == nil {
+            t.Fatal("Set with a member of a missing VLAN succeeded")
+        }
+        for _, path := range []*pb.Path{vlanPath("Vlan200"), memberPath("Vlan200", "Ethernet8")} {
+            if err := get(path); status.Code(err) != codes.NotFound {
+                t.Errorf("Get of %v got %v, want NotFound", path, err)
+            }
+        }
+    })
+
+    t.Run("DeleteVlanWithMembers", func(t *testing.T) {
+        req := &pb.SetRequest{Delete: []*pb.Path{
+            memberPath("Vlan100", "Ethernet0"),
+            memberPath("Vlan100", "Ethernet4"),
+            vlanPath("Vlan100"),
+        }}
+        if _, err := gClient.Set(ctx, req); err != nil {
+            t.Fatalf("Delete of VLAN with members failed: %v", err)
+        }
+        if err := get(vlanPath("Vlan100")); status.Code(err) != codes.NotFound {
+            t.Errorf("Get of deleted VLAN got %v, want NotFound", err)
+        }
+    })
+}
+
 // func TestGnmiGet(t *testing.T) {
 //     //t.Log("Start server")
 //     s := createServer(t)
@@ -546,6 +842,25 @@ func TestGnmiGetSet(t *testing.T) {
 //     s.s.Stop()
 // }
 
+// sumLagCounters returns the expected PortChannel data with counters of its members
+func sumLagCounters(members map[string]interface{}) map[string]interface{} {
+    lag := map[string]interface{}{"Members": members}
+    sums := make(map[string]uint64)
+    for _, member := range members {
+        for field, val := range member.(map[string]interface{}) {
+            n, err := strconv.ParseUint(val.(string), 10, 64)
+            if err != nil {
+                continue
+            }
+            sums[field] += n
+        }
+    }
+    for field, n := range sums {
+        lag[field] = strconv.FormatUint(n, 10)
+    }
+    return lag
+}
+
 type tablePathValue struct {
     dbName    string
     tableName string
@@ -713,6 +1028,128 @@ func runTestSubscribe(t *testing.T) {
     json.Unmarshal(countersEthernet68QueuesAliasByte, &countersEthernet68QueuesAliasJsonUpdate)
     countersEthernet68QueuesAliasJsonUpdate["Ethernet68/1:1"] = eth68_1
 
+    // PortChannel0001 with members Ethernet1 and Ethernet68
+    fileName = "testdata/COUNTERS:Ethernet1.txt"
+    countersEthernet1Byte, err := ioutil.ReadFile(fileName)
+    if err != nil {
+        t.Fatalf("read file %v err: %v", fileName, err)
+    }
+    var countersEthernet1Json interface{}
+    json.Unmarshal(countersEthernet1Byte, &countersEthernet1Json)
+    countersLagJson := map[string]interface{}{"PortChannel0001": sumLagCounters(map[string]interface{}{
+        "Ethernet1/1":  countersEthernet1Json,
+        "Ethernet68/1": countersEthernet68Json,
+    })}
+    countersLagJsonPfcUpdate := map[string]interface{}{"PortChannel0001": sumLagCounters(map[string]interface{}{
+        "Ethernet1/1":  countersEthernet1Json,
+        "Ethernet68/1": countersEthernet68JsonPfcUpdate,
+    })}
+    lagPfcJson := sumLagCounters(map[string]interface{}{
+        "Ethernet1/1":  map[string]interface{}{"SAI_PORT_STAT_PFC_7_RX_PKTS": "1"},
+        "Ethernet68/1": map[string]interface{}{"SAI_PORT_STAT_PFC_7_RX_PKTS": "2"},
+    })
+    lagPfcJsonUpdate := sumLagCounters(map[string]interface{}{
+        "Ethernet1/1":  map[string]interface{}{"SAI_PORT_STAT_PFC_7_RX_PKTS": "1"},
+        "Ethernet68/1": map[string]interface{}{"SAI_PORT_STAT_PFC_7_RX_PKTS": "4"},
+    })
+
+    // Priority groups, watermarks and buffer pools
+    fileName = "testdata/COUNTERS_WATERMARKS.txt"
+    countersWatermarksByte, err := ioutil.ReadFile(fileName)
+    if err != nil {
+        t.Fatalf("read file %v err: %v", fileName, err)
+    }
+    var countersWatermarks map[string]interface{}
+    json.Unmarshal(countersWatermarksByte, &countersWatermarks)
+    eth68Pg3 := countersWatermarks["COUNTERS:oid:0x1a000000000620"].(map[string]interface{})
+    eth68Pg3Update := map[string]interface{}{}
+    for k, v := range eth68Pg3 {
+        eth68Pg3Update[k] = v
+    }
+    eth68Pg3Update["SAI_INGRESS_PRIORITY_GROUP_STAT_PACKETS"] = "5"
+    countersEthernet68PgAliasJson := map[string]interface{}{
+        "Ethernet68/1:0": map[string]interface{}{},
+        "Ethernet68/1:3": eth68Pg3,
+        "Ethernet68/1:4": map[string]interface{}{},
+    }
+    countersEthernet68PgAliasJsonUpdate := map[string]interface{}{
+        "Ethernet68/1:0": map[string]interface{}{},
+        "Ethernet68/1:3": eth68Pg3Update,
+        "Ethernet68/1:4": map[string]interface{}{},
+    }
+    countersEthernet68WatermarksJson := map[string]interface{}{
+        "PriorityGroups": map[string]interface{}{
+            "Ethernet68:3": map[string]interface{}{
+                "USER_WATERMARKS":       countersWatermarks["USER_WATERMARKS:oid:0x1a000000000620"],
+                "PERSISTENT_WATERMARKS": countersWatermarks["PERSISTENT_WATERMARKS:oid:0x1a000000000620"],
+            },
+        },
+        "Queues": map[string]interface{}{
+            "Ethernet68:3": map[string]interface{}{
+                "USER_WATERMARKS": countersWatermarks["USER_WATERMARKS:oid:0x1500000000091e"],
+            },
+        },
+    }
+    countersEthernet68WatermarksJsonUpdate := map[string]interface{}{
+        "PriorityGroups": countersEthernet68WatermarksJson["PriorityGroups"],
+        "Queues": map[string]interface{}{
+            "Ethernet68:3": map[string]interface{}{
+                "USER_WATERMARKS": map[string]interface{}{"SAI_QUEUE_STAT_SHARED_WATERMARK_BYTES": "2048"},
+            },
+        },
+    }
+    countersBufferPoolJson := map[string]interface{}{
+        "USER_WATERMARKS":       countersWatermarks["USER_WATERMARKS:oid:0x18000000000c10"],
+        "PERSISTENT_WATERMARKS": countersWatermarks["PERSISTENT_WATERMARKS:oid:0x18000000000c10"],
+    }
+
+    // Router interfaces and ACL rules
+    fileName = "testdata/COUNTERS_RIF_ACL.txt"
+    countersRifAclByte, err := ioutil.ReadFile(fileName)
+    if err != nil {
+        t.Fatalf("read file %v err: %v", fileName, err)
+    }
+    var countersRifAcl map[string]interface{}
+    json.Unmarshal(countersRifAclByte, &countersRifAcl)
+    countersRifEth68Json := countersRifAcl["COUNTERS:oid:0x6000000000a2e"].(map[string]interface{})
+    countersRifEth68JsonUpdate := map[string]interface{}{}
+    for k, v := range countersRifEth68Json {
+        countersRifEth68JsonUpdate[k] = v
+    }
+    countersRifEth68JsonUpdate["SAI_ROUTER_INTERFACE_STAT_IN_PACKETS"] = "20"
+    countersAclJson := map[string]interface{}{
+        "DATAACL": map[string]interface{}{
+            "RULE_1": countersRifAcl["COUNTERS:DATAACL:RULE_1"],
+            "RULE_2": countersRifAcl["COUNTERS:DATAACL:RULE_2"],
+        },
+        "EVERFLOW": map[string]interface{}{
+            "RULE_1": countersRifAcl["COUNTERS:EVERFLOW:RULE_1"],
+        },
+    }
+    countersInterfacesVlanJson := map[string]interface{}{
+        "Vlan1000": countersRifAcl["COUNTERS:oid:0x6000000000a30"],
+    }
+    countersInterfacesInPktsJson := map[string]interface{}{
+        "Ethernet68/1": map[string]interface{}{"SAI_ROUTER_INTERFACE_STAT_IN_PACKETS": "16"},
+        "Vlan1000":     map[string]interface{}{"SAI_ROUTER_INTERFACE_STAT_IN_PACKETS": "0"},
+    }
+    countersInterfacesInPktsJsonUpdate := map[string]interface{}{
+        "Ethernet68/1": map[string]interface{}{"SAI_ROUTER_INTERFACE_STAT_IN_PACKETS": "20"},
+        "Vlan1000":     map[string]interface{}{"SAI_ROUTER_INTERFACE_STAT_IN_PACKETS": "0"},
+    }
+    // Counters of Ethernet68 are not changed between samples
+    countersEthernet68RatesJson := map[string]interface{}{}
+    for _, rate := range []string{"RX_BPS", "TX_BPS", "RX_PPS", "TX_PPS", "RX_ERR_PPS", "TX_ERR_PPS", "RX_DROP_PPS", "TX_DROP_PPS"} {
+        countersEthernet68RatesJson[rate] = "0.00"
+    }
+    countersAclJsonUpdate := map[string]interface{}{
+        "DATAACL": map[string]interface{}{
+            "RULE_1": map[string]interface{}{"Bytes": "1500", "Packets": "11"},
+            "RULE_2": countersRifAcl["COUNTERS:DATAACL:RULE_2"],
+        },
+        "EVERFLOW": countersAclJson["EVERFLOW"],
+    }
+
     tests := []struct {
         desc     string
         q        client.Query
@@ -996,121 +1433,347 @@ func runTestSubscribe(t *testing.T) {
                 client.Update{Path: []string{"COUNTERS", "Ethernet*", "Pfcwd"}, TS: time.Unix(0, 200), Val: countersEthernet68PfcwdAliasJsonUpdate},
             },
         }, {
-            desc: "poll query for table COUNTERS_PORT_NAME_MAP with new field test_field",
-            poll: 3,
+            desc: "stream query for COUNTERS/PortChannel* with update of member field value",
             q: client.Query{
                 Target:  "COUNTERS_DB",
-                Type:    client.Poll,
-                Queries: []client.Path{{"COUNTERS_PORT_NAME_MAP"}},
+                Type:    client.Stream,
+                Queries: []client.Path{{"COUNTERS", "PortChannel*"}},
                 TLS:     &tls.Config{InsecureSkipVerify: true},
             },
             updates: []tablePathValue{{
                 dbName:    "COUNTERS_DB",
-                tableName: "COUNTERS_PORT_NAME_MAP",
-                field:     "test_field",
-                value:     "test_value",
+                tableName: "COUNTERS",
+                tableKey:  "oid:0x1000000000039", // "Ethernet68": "oid:0x1000000000039",
+                delimitor: ":",
+                field:     "SAI_PORT_STAT_PFC_7_RX_PKTS",
+                value:     "4", // being changed to 4 from 2
             }},
             wantNoti: []client.Notification{
                 client.Connected{},
-                // We are starting from the result data of "stream query for table with update of new field",
-                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJson},
-                client.Sync{},
-                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJsonUpdate},
-                client.Sync{},
-                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJsonUpdate},
-                client.Sync{},
-                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJsonUpdate},
+                client.Update{Path: []string{"COUNTERS", "PortChannel*"}, TS: time.Unix(0, 200), Val: countersLagJson},
                 client.Sync{},
+                client.Update{Path: []string{"COUNTERS", "PortChannel*"}, TS: time.Unix(0, 200), Val: countersLagJsonPfcUpdate},
             },
         }, {
-            desc: "poll query for table COUNTERS_PORT_NAME_MAP with test_field delete",
-            poll: 3,
+            desc: "poll query for COUNTERS/PortChannel0001/SAI_PORT_STAT_PFC_7_RX_PKTS with member field value change",
+            poll: 1,
             q: client.Query{
                 Target:  "COUNTERS_DB",
                 Type:    client.Poll,
-                Queries: []client.Path{{"COUNTERS_PORT_NAME_MAP"}},
+                Queries: []client.Path{{"COUNTERS", "PortChannel0001", "SAI_PORT_STAT_PFC_7_RX_PKTS"}},
                 TLS:     &tls.Config{InsecureSkipVerify: true},
             },
-            prepares: []tablePathValue{{
-                dbName:    "COUNTERS_DB",
-                tableName: "COUNTERS_PORT_NAME_MAP",
-                field:     "test_field",
-                value:     "test_value",
-            }},
             updates: []tablePathValue{{
                 dbName:    "COUNTERS_DB",
-                tableName: "COUNTERS_PORT_NAME_MAP",
-                field:     "test_field",
-                op:        "hdel",
+                tableName: "COUNTERS",
+                tableKey:  "oid:0x1000000000039", // "Ethernet68": "oid:0x1000000000039",
+                delimitor: ":",
+                field:     "SAI_PORT_STAT_PFC_7_RX_PKTS",
+                value:     "4", // being changed to 4 from 2
             }},
             wantNoti: []client.Notification{
                 client.Connected{},
-                // We are starting from the result data of "stream query for table with update of new field",
-                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJsonUpdate},
-                client.Sync{},
-                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJson},
-                client.Sync{},
-                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJson},
+                client.Update{Path: []string{"COUNTERS", "PortChannel0001", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
+                    TS: time.Unix(0, 200), Val: lagPfcJson},
                 client.Sync{},
-                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJson},
+                client.Update{Path: []string{"COUNTERS", "PortChannel0001", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
+                    TS: time.Unix(0, 200), Val: lagPfcJsonUpdate},
                 client.Sync{},
             },
         }, {
-            desc: "poll query for COUNTERS/Ethernet68/SAI_PORT_STAT_PFC_7_RX_PKTS with field value change",
-            poll: 3,
+            desc: "poll query for COUNTERS/Ethernet68/1/PriorityGroups with field value change",
+            poll: 1,
             q: client.Query{
                 Target:  "COUNTERS_DB",
                 Type:    client.Poll,
-                Queries: []client.Path{{"COUNTERS", "Ethernet68", "SAI_PORT_STAT_PFC_7_RX_PKTS"}},
+                Queries: []client.Path{{"COUNTERS", "Ethernet68/1", "PriorityGroups"}},
                 TLS:     &tls.Config{InsecureSkipVerify: true},
             },
             updates: []tablePathValue{{
                 dbName:    "COUNTERS_DB",
                 tableName: "COUNTERS",
-                tableKey:  "oid:0x1000000000039", // "Ethernet68": "oid:0x1000000000039",
+                tableKey:  "oid:0x1a000000000620", // "Ethernet68:3": "oid:0x1a000000000620",
                 delimitor: ":",
-                field:     "SAI_PORT_STAT_PFC_7_RX_PKTS",
-                value:     "4", // being changed to 4 from 2
+                field:     "SAI_INGRESS_PRIORITY_GROUP_STAT_PACKETS",
+                value:     "5", // being changed to 5 from 0
             }},
             wantNoti: []client.Notification{
                 client.Connected{},
-                client.Update{Path: []string{"COUNTERS", "Ethernet68", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
-                    TS: time.Unix(0, 200), Val: "2"},
-                client.Sync{},
-                client.Update{Path: []string{"COUNTERS", "Ethernet68", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
-                    TS: time.Unix(0, 200), Val: "4"},
-                client.Sync{},
-                client.Update{Path: []string{"COUNTERS", "Ethernet68", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
-                    TS: time.Unix(0, 200), Val: "4"},
+                client.Update{Path: []string{"COUNTERS", "Ethernet68/1", "PriorityGroups"},
+                    TS: time.Unix(0, 200), Val: countersEthernet68PgAliasJson},
                 client.Sync{},
-                client.Update{Path: []string{"COUNTERS", "Ethernet68", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
-                    TS: time.Unix(0, 200), Val: "4"},
+                client.Update{Path: []string{"COUNTERS", "Ethernet68/1", "PriorityGroups"},
+                    TS: time.Unix(0, 200), Val: countersEthernet68PgAliasJsonUpdate},
                 client.Sync{},
             },
         }, {
-            desc: "(use vendor alias) poll query for COUNTERS/[Ethernet68/1]/SAI_PORT_STAT_PFC_7_RX_PKTS with field value change",
-            poll: 3,
+            desc: "poll query for COUNTERS/Ethernet68/Watermarks with queue watermark change",
+            poll: 1,
             q: client.Query{
                 Target:  "COUNTERS_DB",
                 Type:    client.Poll,
-                Queries: []client.Path{{"COUNTERS", "Ethernet68/1", "SAI_PORT_STAT_PFC_7_RX_PKTS"}},
+                Queries: []client.Path{{"COUNTERS", "Ethernet68", "Watermarks"}},
                 TLS:     &tls.Config{InsecureSkipVerify: true},
             },
             updates: []tablePathValue{{
                 dbName:    "COUNTERS_DB",
-                tableName: "COUNTERS",
-                tableKey:  "oid:0x1000000000039", // "Ethernet68": "oid:0x1000000000039",
+                tableName: "USER_WATERMARKS",
+                tableKey:  "oid:0x1500000000091e", // "Ethernet68:3": "oid:0x1500000000091e",
                 delimitor: ":",
-                field:     "SAI_PORT_STAT_PFC_7_RX_PKTS",
-                value:     "4", // being changed to 4 from 2
+                field:     "SAI_QUEUE_STAT_SHARED_WATERMARK_BYTES",
+                value:     "2048", // being changed to 2048 from 1024
             }},
             wantNoti: []client.Notification{
                 client.Connected{},
-                client.Update{Path: []string{"COUNTERS", "Ethernet68/1", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
-                    TS: time.Unix(0, 200), Val: "2"},
+                client.Update{Path: []string{"COUNTERS", "Ethernet68", "Watermarks"},
+                    TS: time.Unix(0, 200), Val: countersEthernet68WatermarksJson},
                 client.Sync{},
-                client.Update{Path: []string{"COUNTERS", "Ethernet68/1", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
-                    TS: time.Unix(0, 200), Val: "4"},
+                client.Update{Path: []string{"COUNTERS", "Ethernet68", "Watermarks"},
+                    TS: time.Unix(0, 200), Val: countersEthernet68WatermarksJsonUpdate},
+                client.Sync{},
+            },
+        }, {
+            desc: "poll query for COUNTERS/BufferPools/ingress_lossless_pool",
+            poll: 1,
+            q: client.Query{
+                Target:  "COUNTERS_DB",
+                Type:    client.Poll,
+                Queries: []client.Path{{"COUNTERS", "BufferPools", "ingress_lossless_pool"}},
+                TLS:     &tls.Config{InsecureSkipVerify: true},
+            },
+            wantNoti: []client.Notification{
+                client.Connected{},
+                client.Update{Path: []string{"COUNTERS", "BufferPools", "ingress_lossless_pool"},
+                    TS: time.Unix(0, 200), Val: countersBufferPoolJson},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS", "BufferPools", "ingress_lossless_pool"},
+                    TS: time.Unix(0, 200), Val: countersBufferPoolJson},
+                client.Sync{},
+            },
+        }, {
+            desc: "poll query for COUNTERS/RIF/Ethernet68/1 with field value change",
+            poll: 1,
+            q: client.Query{
+                Target:  "COUNTERS_DB",
+                Type:    client.Poll,
+                Queries: []client.Path{{"COUNTERS", "RIF", "Ethernet68/1"}},
+                TLS:     &tls.Config{InsecureSkipVerify: true},
+            },
+            updates: []tablePathValue{{
+                dbName:    "COUNTERS_DB",
+                tableName: "COUNTERS",
+                tableKey:  "oid:0x6000000000a2e", // "Ethernet68": "oid:0x6000000000a2e",
+                delimitor: ":",
+                field:     "SAI_ROUTER_INTERFACE_STAT_IN_PACKETS",
+                value:     "20", // being changed to 20 from 16
+            }},
+            wantNoti: []client.Notification{
+                client.Connected{},
+                client.Update{Path: []string{"COUNTERS", "RIF", "Ethernet68/1"},
+                    TS: time.Unix(0, 200), Val: countersRifEth68Json},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS", "RIF", "Ethernet68/1"},
+                    TS: time.Unix(0, 200), Val: countersRifEth68JsonUpdate},
+                client.Sync{},
+            },
+        }, {
+            desc: "poll query for COUNTERS/Ethernet68/Rates",
+            poll: 1,
+            q: client.Query{
+                Target:  "COUNTERS_DB",
+                Type:    client.Poll,
+                Queries: []client.Path{{"COUNTERS", "Ethernet68", "Rates"}},
+                TLS:     &tls.Config{InsecureSkipVerify: true},
+            },
+            wantNoti: []client.Notification{
+                client.Connected{},
+                client.Update{Path: []string{"COUNTERS", "Ethernet68", "Rates"}, TS: time.Unix(0, 200), Val: countersEthernet68RatesJson},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS", "Ethernet68", "Rates"}, TS: time.Unix(0, 200), Val: countersEthernet68RatesJson},
+                client.Sync{},
+            },
+        }, {
+            desc: "poll query for defined virtual path COUNTERS/Interfaces/Vlan*",
+            poll: 1,
+            q: client.Query{
+                Target:  "COUNTERS_DB",
+                Type:    client.Poll,
+                Queries: []client.Path{{"COUNTERS", "Interfaces", "Vlan*"}},
+                TLS:     &tls.Config{InsecureSkipVerify: true},
+            },
+            wantNoti: []client.Notification{
+                client.Connected{},
+                client.Update{Path: []string{"COUNTERS", "Interfaces", "Vlan*"}, TS: time.Unix(0, 200), Val: countersInterfacesVlanJson},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS", "Interfaces", "Vlan*"}, TS: time.Unix(0, 200), Val: countersInterfacesVlanJson},
+                client.Sync{},
+            },
+        }, {
+            desc: "poll query for defined virtual path COUNTERS/Interfaces/*/SAI_ROUTER_INTERFACE_STAT_IN_PACKETS with field value change",
+            poll: 1,
+            q: client.Query{
+                Target:  "COUNTERS_DB",
+                Type:    client.Poll,
+                Queries: []client.Path{{"COUNTERS", "Interfaces", "*", "SAI_ROUTER_INTERFACE_STAT_IN_PACKETS"}},
+                TLS:     &tls.Config{InsecureSkipVerify: true},
+            },
+            updates: []tablePathValue{{
+                dbName:    "COUNTERS_DB",
+                tableName: "COUNTERS",
+                tableKey:  "oid:0x6000000000a2e", // "Ethernet68": "oid:0x6000000000a2e",
+                delimitor: ":",
+                field:     "SAI_ROUTER_INTERFACE_STAT_IN_PACKETS",
+                value:     "20", // being changed to 20 from 16
+            }},
+            wantNoti: []client.Notification{
+                client.Connected{},
+                client.Update{Path: []string{"COUNTERS", "Interfaces", "*", "SAI_ROUTER_INTERFACE_STAT_IN_PACKETS"},
+                    TS: time.Unix(0, 200), Val: countersInterfacesInPktsJson},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS", "Interfaces", "*", "SAI_ROUTER_INTERFACE_STAT_IN_PACKETS"},
+                    TS: time.Unix(0, 200), Val: countersInterfacesInPktsJsonUpdate},
+                client.Sync{},
+            },
+        }, {
+            desc: "poll query for ACL/*/* with rule counter change",
+            poll: 1,
+            q: client.Query{
+                Target:  "COUNTERS_DB",
+                Type:    client.Poll,
+                Queries: []client.Path{{"ACL", "*", "*"}},
+                TLS:     &tls.Config{InsecureSkipVerify: true},
+            },
+            updates: []tablePathValue{{
+                dbName:    "COUNTERS_DB",
+                tableName: "COUNTERS",
+                tableKey:  "DATAACL:RULE_1",
+                delimitor: ":",
+                field:     "Packets",
+                value:     "11", // being changed to 11 from 10
+            }},
+            wantNoti: []client.Notification{
+                client.Connected{},
+                client.Update{Path: []string{"ACL", "*", "*"}, TS: time.Unix(0, 200), Val: countersAclJson},
+                client.Sync{},
+                client.Update{Path: []string{"ACL", "*", "*"}, TS: time.Unix(0, 200), Val: countersAclJsonUpdate},
+                client.Sync{},
+            },
+        }, {
+            desc: "poll query for table COUNTERS_PORT_NAME_MAP with new field test_field",
+            poll: 3,
+            q: client.Query{
+                Target:  "COUNTERS_DB",
+                Type:    client.Poll,
+                Queries: []client.Path{{"COUNTERS_PORT_NAME_MAP"}},
+                TLS:     &tls.Config{InsecureSkipVerify: true},
+            },
+            updates: []tablePathValue{{
+                dbName:    "COUNTERS_DB",
+                tableName: "COUNTERS_PORT_NAME_MAP",
+                field:     "test_field",
+                value:     "test_value",
+            }},
+            wantNoti: []client.Notification{
+                client.Connected{},
+                // We are starting from the result data of "stream query for table with update of new field",
+                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJson},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJsonUpdate},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJsonUpdate},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJsonUpdate},
+                client.Sync{},
+            },
+        }, {
+            desc: "poll query for table COUNTERS_PORT_NAME_MAP with test_field delete",
+            poll: 3,
+            q: client.Query{
+                Target:  "COUNTERS_DB",
+                Type:    client.Poll,
+                Queries: []client.Path{{"COUNTERS_PORT_NAME_MAP"}},
+                TLS:     &tls.Config{InsecureSkipVerify: true},
+            },
+            prepares: []tablePathValue{{
+                dbName:    "COUNTERS_DB",
+                tableName: "COUNTERS_PORT_NAME_MAP",
+                field:     "test_field",
+                value:     "test_value",
+            }},
+            updates: []tablePathValue{{
+                dbName:    "COUNTERS_DB",
+                tableName: "COUNTERS_PORT_NAME_MAP",
+                field:     "test_field",
+                op:        "hdel",
+            }},
+            wantNoti: []client.Notification{
+                client.Connected{},
+                // We are starting from the result data of "stream query for table with update of new field",
+                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJsonUpdate},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJson},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJson},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS_PORT_NAME_MAP"}, TS: time.Unix(0, 200), Val: countersPortNameMapJson},
+                client.Sync{},
+            },
+        }, {
+            desc: "poll query for COUNTERS/Ethernet68/SAI_PORT_STAT_PFC_7_RX_PKTS with field value change",
+            poll: 3,
+            q: client.Query{
+                Target:  "COUNTERS_DB",
+                Type:    client.Poll,
+                Queries: []client.Path{{"COUNTERS", "Ethernet68", "SAI_PORT_STAT_PFC_7_RX_PKTS"}},
+                TLS:     &tls.Config{InsecureSkipVerify: true},
+            },
+            updates: []tablePathValue{{
+                dbName:    "COUNTERS_DB",
+                tableName: "COUNTERS",
+                tableKey:  "oid:0x1000000000039", // "Ethernet68": "oid:0x1000000000039",
+                delimitor: ":",
+                field:     "SAI_PORT_STAT_PFC_7_RX_PKTS",
+                value:     "4", // being changed to 4 from 2
+            }},
+            wantNoti: []client.Notification{
+                client.Connected{},
+                client.Update{Path: []string{"COUNTERS", "Ethernet68", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
+                    TS: time.Unix(0, 200), Val: "2"},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS", "Ethernet68", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
+                    TS: time.Unix(0, 200), Val: "4"},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS", "Ethernet68", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
+                    TS: time.Unix(0, 200), Val: "4"},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS", "Ethernet68", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
+                    TS: time.Unix(0, 200), Val: "4"},
+                client.Sync{},
+            },
+        }, {
+            desc: "(use vendor alias) poll query for COUNTERS/[Ethernet68/1]/SAI_PORT_STAT_PFC_7_RX_PKTS with field value change",
+            poll: 3,
+            q: client.Query{
+                Target:  "COUNTERS_DB",
+                Type:    client.Poll,
+                Queries: []client.Path{{"COUNTERS", "Ethernet68/1", "SAI_PORT_STAT_PFC_7_RX_PKTS"}},
+                TLS:     &tls.Config{InsecureSkipVerify: true},
+            },
+            updates: []tablePathValue{{
+                dbName:    "COUNTERS_DB",
+                tableName: "COUNTERS",
+                tableKey:  "oid:0x1000000000039", // "Ethernet68": "oid:0x1000000000039",
+                delimitor: ":",
+                field:     "SAI_PORT_STAT_PFC_7_RX_PKTS",
+                value:     "4", // being changed to 4 from 2
+            }},
+            wantNoti: []client.Notification{
+                client.Connected{},
+                client.Update{Path: []string{"COUNTERS", "Ethernet68/1", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
+                    TS: time.Unix(0, 200), Val: "2"},
+                client.Sync{},
+                client.Update{Path: []string{"COUNTERS", "Ethernet68/1", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
+                    TS: time.Unix(0, 200), Val: "4"},
                 client.Sync{},
                 client.Update{Path: []string{"COUNTERS", "Ethernet68/1", "SAI_PORT_STAT_PFC_7_RX_PKTS"},
                     TS: time.Unix(0, 200), Val: "4"},
@@ -1529,6 +2192,35 @@ func TestGNOI(t *testing.T) {
             t.Fatalf("Invalid Output Filename: %s", resp.Output.OutputFilename)
         }
     })
+    t.Run("SonicAction", func(t *testing.T) {
+        sc := sgpb.NewSonicServiceClient(conn)
+        req := &sgpb.ActionRequest{
+            Path:      "/sonic-tests:sum",
+            JsonInput: `{"sonic-tests:input": {"left": 1, "right": 2}}`,
+        }
+        resp, err := sc.Action(ctx, req)
+        if err != nil {
+            t.Fatal(err.Error())
+        }
+        sum := &sgpb.SumResponse{}
+        if err = json.Unmarshal([]byte(resp.JsonOutput), sum); err != nil {
+            t.Fatalf("Invalid output %v: %v", resp.JsonOutput, err)
+        }
+        if sum.GetOutput().GetResult() != 3 {
+            t.Fatalf("got sum %v, want 3", resp.JsonOutput)
+        }
+    })
+    t.Run("SonicActionInvalid", func(t *testing.T) {
+        sc := sgpb.NewSonicServiceClient(conn)
+        for _, req := range []*sgpb.ActionRequest{
+            {Path: "sum", JsonInput: "{}"},
+            {Path: "/sonic-tests:sum", JsonInput: "{left: 1"},
+        } {
+            if _, err := sc.Action(ctx, req); status.Code(err) != codes.InvalidArgument {
+                t.Errorf("got %v for %v, want InvalidArgument", err, req)
+            }
+        }
+    })
 
     type configData struct {
 	    source string
@@ -1568,9 +2260,1241 @@ func TestGNOI(t *testing.T) {
 }
 
 
-func init() {
-    // Inform gNMI server to use redis tcp localhost connection
-    sdc.UseRedisLocalTcpPort = true
-}
+func TestCounterBaseline(t *testing.T) {
+    s := createServer(t, 8084)
+    go runServer(t, s)
+    defer s.s.Stop()
+
+    prepareDb(t)
 
+    dir, err := ioutil.TempDir("", "counter_baseline")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+    sdc.BaselineDir = dir
+
+    tlsConfig := &tls.Config{InsecureSkipVerify: true}
+    opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+
+    targetAddr := "127.0.0.1:8084"
+    conn, err := grpc.Dial(targetAddr, opts...)
+    if err != nil {
+        t.Fatalf("Dialing to %q failed: %v", targetAddr, err)
+    }
+    defer conn.Close()
+
+    sc := sgpb.NewSonicServiceClient(conn)
+    gClient := pb.NewGNMIClient(conn)
+    ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+    defer cancel()
+
+    fileName := "testdata/COUNTERS:Ethernet68.txt"
+    countersEthernet68Byte, err := ioutil.ReadFile(fileName)
+    if err != nil {
+        t.Fatalf("read file %v err: %v", fileName, err)
+    }
+    var countersEthernet68Json map[string]interface{}
+    json.Unmarshal(countersEthernet68Byte, &countersEthernet68Json)
+    // All counters are 0 relative to the baseline but the increased one
+    countersEthernet68BaselineJson := map[string]interface{}{}
+    for field, val := range countersEthernet68Json {
+        if _, err := strconv.ParseUint(val.(string), 10, 64); err == nil {
+            val = "0"
+        }
+        countersEthernet68BaselineJson[field] = val
+    }
+    countersEthernet68BaselineJson["SAI_PORT_STAT_PFC_7_RX_PKTS"] = "4"
+
+    getBaseline := func(name string) (*pb.GetResponse, error) {
+        req := &pb.GetRequest{
+            Prefix: &pb.Path{Target: "COUNTERS_DB"},
+            Path: []*pb.Path{{
+                Elem: []*pb.PathElem{
+                    {Name: "COUNTERS"},
+                    {Name: "Ethernet68", Key: map[string]string{"baseline": name}},
+                },
+            }},
+            Encoding: pb.Encoding_JSON_IETF,
+        }
+        return gClient.Get(ctx, req)
+    }
 
+    t.Run("SaveCounterBaseline", func(t *testing.T) {
+        req := &sgpb.SaveCounterBaselineRequest{
+            Name:  "test",
+            Paths: []string{"COUNTERS/Ethernet68"},
+        }
+        resp, err := sc.SaveCounterBaseline(ctx, req)
+        if err != nil {
+            t.Fatal(err.Error())
+        }
+        if resp.NumKeys != 1 {
+            t.Fatalf("Saved %d counters keys, want 1", resp.NumKeys)
+        }
+    })
+
+    t.Run("SaveInvalidCounterBaseline", func(t *testing.T) {
+        _, err := sc.SaveCounterBaseline(ctx, &sgpb.SaveCounterBaselineRequest{Name: "invalid"})
+        if status.Code(err) != codes.InvalidArgument {
+            t.Fatalf("got %v, want InvalidArgument for baseline without path", err)
+        }
+    })
+
+    rclient := getRedisClient(t)
+    defer rclient.Close()
+    rclient.HIncrBy("COUNTERS:oid:0x1000000000039", "SAI_PORT_STAT_PFC_7_RX_PKTS", 4)
+
+    t.Run("GetRelativeToBaseline", func(t *testing.T) {
+        resp, err := getBaseline("test")
+        if err != nil {
+            t.Fatal(err.Error())
+        }
+        var gotVal interface{}
+        val := resp.GetNotification()[0].GetUpdate()[0].GetVal()
+        if err := json.Unmarshal(val.GetJsonIetfVal(), &gotVal); err != nil {
+            t.Fatalf("error in unmarshaling IETF JSON data to json container: %v", err)
+        }
+        if diff := pretty.Compare(countersEthernet68BaselineJson, gotVal); diff != "" {
+            t.Errorf("unexpected counters relative to baseline (-want +got):\n%s", diff)
+        }
+    })
+
+    t.Run("GetUnknownBaseline", func(t *testing.T) {
+        _, err := getBaseline("unknown")
+        if status.Code(err) != codes.NotFound {
+            t.Fatalf("got %v, want NotFound for unknown baseline", err)
+        }
+    })
+
+    t.Run("DeleteCounterBaseline", func(t *testing.T) {
+        _, err := sc.DeleteCounterBaseline(ctx, &sgpb.DeleteCounterBaselineRequest{Name: "test"})
+        if err != nil {
+            t.Fatal(err.Error())
+        }
+        if _, err = getBaseline("test"); status.Code(err) != codes.NotFound {
+            t.Fatalf("got %v, want NotFound for deleted baseline", err)
+        }
+        _, err = sc.DeleteCounterBaseline(ctx, &sgpb.DeleteCounterBaselineRequest{Name: "test"})
+        if status.Code(err) != codes.NotFound {
+            t.Fatalf("got %v, want NotFound deleting unknown baseline", err)
+        }
+    })
+}
+
+func init() {
+    // Inform gNMI server to use redis tcp localhost connection
+    sdc.UseRedisLocalTcpPort = true
+    // DB layout of the local redis server, default layout is used otherwise
+    if err := sdc.LoadDbConfig("testdata/database_config.json"); err != nil {
+        fmt.Println(err)
+    }
+    // Virtual paths "COUNTERS/Interfaces/*" and "COUNTERS/Interfaces/*/*" on router interfaces
+    if err := sdc.LoadVirtualPaths("testdata/virtual_paths.json"); err != nil {
+        fmt.Println(err)
+    }
+}
+
+
+
+func TestSessionQueuePolicies(t *testing.T) {
+    update := func(name string, val string) *spb.Value {
+        return &spb.Value{
+            Path: &pb.Path{Elem: []*pb.PathElem{{Name: name}}},
+            Val:  &pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: val}},
+        }
+    }
+    drain := func(sq *sessionQueue) []string {
+        sq.close()
+        var vals []string
+        for {
+            v, err := sq.next(context.Background())
+            if err != nil {
+                return vals
+            }
+            if v.GetSyncResponse() {
+                vals = append(vals, "sync")
+            } else {
+                vals = append(vals, v.GetPath().GetElem()[0].GetName()+"="+v.GetVal().GetStringVal())
+            }
+        }
+    }
+
+    t.Run("coalesce", func(t *testing.T) {
+        sq := newSessionQueue(2, PolicyCoalesce)
+        sq.put(update("a", "1"))
+        sq.put(update("b", "1"))
+        sq.put(update("a", "2"))
+        sq.put(update("c", "1"))
+        sq.put(update("a", "3"))
+        want := []string{"a=3", "b=1", "c=1"}
+        if got := drain(sq); pretty.Compare(got, want) != "" {
+            t.Errorf("got %v, want %v", got, want)
+        }
+        if coalesced, dropped := sq.stats(); coalesced != 2 || dropped != 0 {
+            t.Errorf("got coalesced %v dropped %v, want 2 0", coalesced, dropped)
+        }
+    })
+
+    t.Run("drop_oldest", func(t *testing.T) {
+        sq := newSessionQueue(2, PolicyDropOldest)
+        sq.put(&spb.Value{SyncResponse: true})
+        sq.put(update("a", "1"))
+        sq.put(update("a", "2"))
+        sq.put(update("b", "1"))
+        want := []string{"sync", "b=1"}
+        if got := drain(sq); pretty.Compare(got, want) != "" {
+            t.Errorf("got %v, want %v", got, want)
+        }
+        if coalesced, dropped := sq.stats(); coalesced != 0 || dropped != 2 {
+            t.Errorf("got coalesced %v dropped %v, want 0 2", coalesced, dropped)
+        }
+    })
+
+    t.Run("drop_oldest keeps order", func(t *testing.T) {
+        sq := newSessionQueue(3, PolicyDropOldest)
+        sq.put(update("a", "1"))
+        sq.put(&spb.Value{SyncResponse: true})
+        sq.put(update("b", "1"))
+        sq.put(update("c", "1"))
+        sq.put(update("d", "1"))
+        want := []string{"sync", "c=1", "d=1"}
+        if got := drain(sq); pretty.Compare(got, want) != "" {
+            t.Errorf("got %v, want %v", got, want)
+        }
+        if coalesced, dropped := sq.stats(); coalesced != 0 || dropped != 2 {
+            t.Errorf("got coalesced %v dropped %v, want 0 2", coalesced, dropped)
+        }
+    })
+
+    t.Run("disconnect", func(t *testing.T) {
+        sq := newSessionQueue(1, PolicyDisconnect)
+        sq.put(update("a", "1"))
+        sq.put(update("a", "2"))
+        if _, err := sq.next(context.Background()); status.Code(err) != codes.ResourceExhausted {
+            t.Errorf("got error %v, want ResourceExhausted", err)
+        }
+    })
+}
+
+func TestPackNotifications(t *testing.T) {
+    prefix := &pb.Path{Target: "COUNTERS_DB"}
+    value := func(ts int64, names ...string) *spb.Value {
+        v := &spb.Value{Prefix: prefix, Timestamp: ts}
+        for _, name := range names {
+            v.Updates = append(v.Updates, &pb.Update{
+                Path: &pb.Path{Elem: []*pb.PathElem{{Name: name}}},
+                Val:  &pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: name}},
+            })
+        }
+        return v
+    }
+    values := []*spb.Value{
+        value(1, "a", "b"),
+        value(1, "c"),
+        value(2, "d"),
+        {Prefix: &pb.Path{Target: "APPL_DB"}, Timestamp: 2, Path: &pb.Path{Elem: []*pb.PathElem{{Name: "e"}}}},
+    }
+    var got [][]string
+    for _, n := range packNotifications(values) {
+        var names []string
+        for _, u := range n.GetUpdate() {
+            names = append(names, n.GetPrefix().GetTarget()+"/"+u.GetPath().GetElem()[0].GetName())
+        }
+        got = append(got, names)
+    }
+    want := [][]string{
+        {"COUNTERS_DB/a", "COUNTERS_DB/b", "COUNTERS_DB/c"},
+        {"COUNTERS_DB/d"},
+        {"APPL_DB/e"},
+    }
+    if diff := pretty.Compare(got, want); diff != "" {
+        t.Errorf("packNotifications diff (-got +want):\n%s", diff)
+    }
+}
+
+func TestFlattenJSON(t *testing.T) {
+    jsonValue := func(path []*pb.PathElem, data string) *spb.Value {
+        return &spb.Value{
+            Path: &pb.Path{Elem: path},
+            Val:  &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(data)}},
+        }
+    }
+    counters := []*pb.PathElem{{Name: "COUNTERS"}, {Name: "Ethernet68"}}
+    leaves := func(v *spb.Value) []string {
+        var got []string
+        for _, u := range sdc.ValueUpdates(v) {
+            var p string
+            for _, e := range u.GetPath().GetElem() {
+                p += "/" + e.GetName()
+                for k, v := range e.GetKey() {
+                    p += "[" + k + "=" + v + "]"
+                }
+            }
+            got = append(got, p+"="+proto.CompactTextString(u.GetVal()))
+        }
+        return got
+    }
+
+    tests := []struct {
+        desc  string
+        value *spb.Value
+        want  []string
+    }{{
+        desc:  "table key",
+        value: jsonValue(counters, `{"SAI_PORT_STAT_IF_IN_OCTETS": "123"}`),
+        want:  []string{`/COUNTERS/Ethernet68/SAI_PORT_STAT_IF_IN_OCTETS=string_val:"123" `},
+    }, {
+        desc:  "wildcard",
+        value: jsonValue([]*pb.PathElem{{Name: "COUNTERS"}, {Name: "Ethernet*"}}, `{"Ethernet0": {"A": "1"}, "Ethernet4": {"A": "2"}}`),
+        want: []string{
+            `/COUNTERS/Ethernet0/A=string_val:"1" `,
+            `/COUNTERS/Ethernet4/A=string_val:"2" `,
+        },
+    }, {
+        desc: "openconfig list",
+        value: jsonValue([]*pb.PathElem{{Name: "openconfig-interfaces:interfaces"}, {Name: "interface", Key: map[string]string{"name": "Ethernet0"}}},
+            `{"openconfig-interfaces:interface": [{"name": "Ethernet0", "state": {"mtu": 9100, "enabled": true}}]}`),
+        want: []string{
+            `/openconfig-interfaces:interfaces/interface[name=Ethernet0]/name=string_val:"Ethernet0" `,
+            `/openconfig-interfaces:interfaces/interface[name=Ethernet0]/state/enabled=bool_val:true `,
+            `/openconfig-interfaces:interfaces/interface[name=Ethernet0]/state/mtu=int_val:9100 `,
+        },
+    }, {
+        desc:  "list without keys",
+        value: jsonValue(counters, `{"A": "1", "LIST": [{"B": 1}, {"B": 2}]}`),
+        want: []string{
+            `/COUNTERS/Ethernet68/A=string_val:"1" `,
+            `/COUNTERS/Ethernet68/LIST=json_ietf_val:"[{\"B\":1},{\"B\":2}]" `,
+        },
+    }, {
+        desc:  "not json",
+        value: &spb.Value{Path: &pb.Path{Elem: []*pb.PathElem{{Name: "A"}}}, Val: &pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: "1"}}},
+        want:  []string{`/A=string_val:"1" `},
+    }}
+    for _, tt := range tests {
+        t.Run(tt.desc, func(t *testing.T) {
+            if diff := pretty.Compare(leaves(sdc.FlattenValue(tt.value)), tt.want); diff != "" {
+                t.Errorf("FlattenValue diff (-got +want):\n%s", diff)
+            }
+        })
+    }
+
+    t.Run("suppress redundant", func(t *testing.T) {
+        f := &dataFeed{
+            key:    feedKey{suppressRedundant: true},
+            values: make(map[string]*spb.Value),
+            leaves: make(map[string]map[string]bool),
+        }
+        flattenFeedValue(f, jsonValue(counters, `{"A": "1", "B": "1"}`))
+        got := leaves(flattenFeedValue(f, jsonValue(counters, `{"A": "1", "B": "2"}`)))
+        want := []string{`/COUNTERS/Ethernet68/B=string_val:"2" `}
+        if diff := pretty.Compare(got, want); diff != "" {
+            t.Errorf("changed leaves diff (-got +want):\n%s", diff)
+        }
+        // Unchanged value is a heartbeat
+        got = leaves(flattenFeedValue(f, jsonValue(counters, `{"A": "1", "B": "2"}`)))
+        if len(got) != 2 {
+            t.Errorf("got %v, want all leaves", got)
+        }
+    })
+}
+
+func TestGetErrorStatus(t *testing.T) {
+    port := &pb.Path{Elem: []*pb.PathElem{{Name: "PORT"}, {Name: "Ethernet0"}}}
+    queue := &pb.Path{Elem: []*pb.PathElem{{Name: "QUEUE"}}}
+    err := sdc.ErrorStatus(sdc.GetError{
+        {Path: port, Err: redis.Nil},
+        {Path: queue, Err: &net.OpError{Op: "dial", Err: fmt.Errorf("connection refused")}},
+    })
+    st := status.Convert(err)
+    if st.Code() != codes.NotFound {
+        t.Errorf("got code %v, want NotFound of first path", st.Code())
+    }
+    var got []string
+    for _, d := range st.Details() {
+        ri, ok := d.(*errdetails.ResourceInfo)
+        if !ok {
+            t.Fatalf("got detail %T, want ResourceInfo", d)
+        }
+        got = append(got, ri.GetResourceName()+" "+strings.Fields(ri.GetDescription())[0])
+    }
+    want := []string{"/PORT/Ethernet0 NotFound:", "/QUEUE Unavailable:"}
+    if diff := pretty.Compare(got, want); diff != "" {
+        t.Errorf("details diff (-got +want):\n%s", diff)
+    }
+
+    if code := sdc.ErrorCode(context.DeadlineExceeded); code != codes.DeadlineExceeded {
+        t.Errorf("got code %v, want DeadlineExceeded", code)
+    }
+}
+
+func TestTranslErrorStatus(t *testing.T) {
+    const uri = "/openconfig-interfaces:interfaces/interface[name=Ethernet0]"
+    cvlErr := tlerr.TranslibCVLFailure{CVLErrorInfo: cvl.CVLErrorInfo{
+        TableName:        "VLAN_MEMBER",
+        ErrCode:          cvl.CVL_SEMANTIC_DEPENDENT_DATA_MISSING,
+        Keys:             []string{"Vlan10", "Ethernet0"},
+        ConstraintErrMsg: "Vlan10 does not exist",
+    }}
+    tests := []struct {
+        desc       string
+        err        error
+        wantCode   codes.Code
+        wantReason string
+    }{
+        {"not found", tlerr.NotFoundError{Format: "Resource not found"}, codes.NotFound, "NOT_FOUND"},
+        {"invalid args", tlerr.InvalidArgsError{Format: "Invalid MTU"}, codes.InvalidArgument, "INVALID_ARGUMENT"},
+        {"auth failure", tlerr.AuthorizationError{Format: "User not authorized"}, codes.PermissionDenied, "AUTHORIZATION_FAILED"},
+        {"unsupported path", tlerr.NotSupportedError{Format: "Not supported"}, codes.Unimplemented, "NOT_SUPPORTED"},
+        {"conflict", tlerr.TranslibTransactionFail{}, codes.Aborted, "TRANSACTION_CONFLICT"},
+        {"cvl failure", cvlErr, codes.FailedPrecondition, "CVL_VALIDATION_FAILED"},
+        {"unknown", fmt.Errorf("unexpected"), codes.Unknown, "UNKNOWN"},
+    }
+    for _, tt := range tests {
+        t.Run(tt.desc, func(t *testing.T) {
+            st := status.Convert(transutil.TranslErrorStatus("UPDATE", uri, tt.err))
+            if st.Code() != tt.wantCode {
+                t.Errorf("got code %v, want %v", st.Code(), tt.wantCode)
+            }
+            info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+            if !ok {
+                t.Fatalf("got detail %T, want ErrorInfo", st.Details()[0])
+            }
+            if info.GetReason() != tt.wantReason || info.GetMetadata()["uri"] != uri {
+                t.Errorf("got ErrorInfo %v, want reason %v for %v", info, tt.wantReason, uri)
+            }
+        })
+    }
+
+    t.Run("cvl constraint", func(t *testing.T) {
+        st := status.Convert(transutil.TranslErrorStatus("UPDATE", uri, cvlErr))
+        if !strings.Contains(st.Message(), "Vlan10 does not exist") {
+            t.Errorf("got message %q, want the constraint message", st.Message())
+        }
+        br, ok := st.Details()[1].(*errdetails.BadRequest)
+        if !ok {
+            t.Fatalf("got detail %T, want BadRequest", st.Details()[1])
+        }
+        v := br.GetFieldViolations()[0]
+        if v.GetField() != "VLAN_MEMBER|Vlan10|Ethernet0" || v.GetDescription() != "Vlan10 does not exist" {
+            t.Errorf("got violation %v", v)
+        }
+    })
+
+    t.Run("get error", func(t *testing.T) {
+        path := &pb.Path{Elem: []*pb.PathElem{{Name: "interfaces"}}}
+        err := sdc.ErrorStatus(sdc.GetError{
+            {Path: path, Err: transutil.TranslErrorStatus("GET", "/interfaces", tlerr.AuthorizationError{Format: "denied"})},
+        })
+        st := status.Convert(err)
+        if st.Code() != codes.PermissionDenied || len(st.Details()) != 2 {
+            t.Fatalf("got %v with details %v, want PermissionDenied with path and ErrorInfo", st.Code(), st.Details())
+        }
+        if _, ok := st.Details()[1].(*errdetails.ErrorInfo); !ok {
+            t.Errorf("got detail %T, want ErrorInfo of the path", st.Details()[1])
+        }
+    })
+}
+
+func TestPendingCommits(t *testing.T) {
+    dir, err := ioutil.TempDir("", "pending_commits")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+    sdc.CheckpointDir = dir
+
+    // Changes of commits are told apart by the number of their checkpoint
+    // as namespace
+    var taken int
+    var reverted []string
+    revertedCh := make(chan string, 4)
+    hook := func(pc *pendingCommits) {
+        pc.checkpoint = func() (*sdc.ConfigCheckpoint, error) {
+            taken++
+            return &sdc.ConfigCheckpoint{Namespace: strconv.Itoa(taken)}, nil
+        }
+        pc.delta = func(cp *sdc.ConfigCheckpoint) (*sdc.ConfigDelta, error) {
+            return &sdc.ConfigDelta{Namespace: cp.Namespace}, nil
+        }
+        pc.revert = func(d *sdc.ConfigDelta) error {
+            reverted = append(reverted, d.Namespace)
+            revertedCh <- d.Namespace
+            return nil
+        }
+    }
+    pc := newPendingCommits()
+    hook(pc)
+    apply := func() error { return nil }
+    admin := &common_utils.AuthInfo{User: "admin", AuthEnabled: true, Roles: []string{"admin"}}
+    commit := func(timeout time.Duration) string {
+        id, err := pc.commit("admin", timeout, apply)
+        if err != nil {
+            t.Fatalf("commit failed: %v", err)
+        }
+        return id
+    }
+
+    t.Run("extension", func(t *testing.T) {
+        ext, err := commitExtension("")
+        if err != nil {
+            t.Fatal(err)
+        }
+        req := &pb.SetRequest{Extension: []*gnmi_ext.Extension{ext}}
+        if cc, err := commitConfirmed(req); cc != nil || err != nil {
+            t.Errorf("got %v, %v for timeout 0, want extension skipped", cc, err)
+        }
+        ext.GetRegisteredExt().Msg = []byte("other extension")
+        if cc, err := commitConfirmed(req); cc != nil || err != nil {
+            t.Errorf("got %v, %v for another extension, want it skipped", cc, err)
+        }
+        msg, _ := proto.Marshal(&sgpb.CommitConfirmed{TimeoutSeconds: 7200})
+        ext.GetRegisteredExt().Msg = msg
+        if _, err = commitConfirmed(req); status.Code(err) != codes.InvalidArgument {
+            t.Errorf("got %v for timeout of 2 hours, want InvalidArgument", err)
+        }
+        msg, _ = proto.Marshal(&sgpb.CommitConfirmed{TimeoutSeconds: 60})
+        ext.GetRegisteredExt().Msg = msg
+        cc, err := commitConfirmed(req)
+        if err != nil || cc.GetTimeoutSeconds() != 60 {
+            t.Errorf("got %v, %v, want timeout of 60 seconds", cc, err)
+        }
+        if cc, err = commitConfirmed(&pb.SetRequest{}); cc != nil || err != nil {
+            t.Errorf("got %v, %v for Set without extension", cc, err)
+        }
+    })
+
+    t.Run("failed apply", func(t *testing.T) {
+        _, err := pc.commit("admin", time.Minute, func() error {
+            return status.Error(codes.InvalidArgument, "bad value")
+        })
+        if status.Code(err) != codes.InvalidArgument || len(pc.list()) != 0 {
+            t.Errorf("got %v and %v pending, want apply error and none pending", err, pc.list())
+        }
+    })
+
+    t.Run("confirm", func(t *testing.T) {
+        id := commit(time.Minute)
+        if commits := pc.list(); len(commits) != 1 || commits[0].CommitId != id || commits[0].User != "admin" {
+            t.Fatalf("got pending %v, want commit %v", commits, id)
+        }
+        if err := pc.confirm(id, admin); err != nil {
+            t.Fatal(err)
+        }
+        if err := pc.confirm(id, admin); status.Code(err) != codes.NotFound {
+            t.Errorf("got %v confirming twice, want NotFound", err)
+        }
+        if len(pc.list()) != 0 || len(reverted) != 0 {
+            t.Errorf("got pending %v reverted %v, want none", pc.list(), reverted)
+        }
+    })
+
+    t.Run("owner", func(t *testing.T) {
+        id, err := pc.commit("alice", time.Minute, apply)
+        if err != nil {
+            t.Fatal(err)
+        }
+        bob := &common_utils.AuthInfo{User: "bob", AuthEnabled: true, Roles: []string{"operator"}}
+        if err = pc.confirm(id, bob); status.Code(err) != codes.PermissionDenied {
+            t.Errorf("got %v confirming commit of another user, want PermissionDenied", err)
+        }
+        if err = pc.rollback(id, bob); status.Code(err) != codes.PermissionDenied {
+            t.Errorf("got %v cancelling commit of another user, want PermissionDenied", err)
+        }
+        alice := &common_utils.AuthInfo{User: "alice", AuthEnabled: true, Roles: []string{"operator"}}
+        if err = pc.confirm(id, alice); err != nil {
+            t.Errorf("got %v confirming own commit", err)
+        }
+        if len(pc.list()) != 0 || len(reverted) != 0 {
+            t.Errorf("got pending %v reverted %v, want none", pc.list(), reverted)
+        }
+    })
+
+    t.Run("cancel", func(t *testing.T) {
+        first := commit(time.Minute)
+        second := commit(time.Minute)
+        third := commit(time.Minute)
+        if err := pc.confirm(third, admin); err != nil {
+            t.Fatal(err)
+        }
+        // Only the changes of the commit cancelled are reverted
+        if err := pc.rollback(first, admin); err != nil {
+            t.Fatal(err)
+        }
+        if commits := pc.list(); len(commits) != 1 || commits[0].CommitId != second {
+            t.Errorf("got pending %v, want only %v", commits, second)
+        }
+        if err := pc.rollback(second, admin); err != nil {
+            t.Fatal(err)
+        }
+        want := []string{strconv.Itoa(taken - 2), strconv.Itoa(taken - 1)}
+        if diff := pretty.Compare(want, reverted); diff != "" {
+            t.Errorf("unexpected commits reverted (-want +got):\n%s", diff)
+        }
+        <-revertedCh
+        <-revertedCh
+        reverted = nil
+    })
+
+    t.Run("timeout", func(t *testing.T) {
+        commit(100 * time.Millisecond)
+        select {
+        case ns := <-revertedCh:
+            if ns != strconv.Itoa(taken) {
+                t.Errorf("got changes of checkpoint %v reverted, want %v", ns, taken)
+            }
+        case <-time.After(5 * time.Second):
+            t.Fatal("commit not rolled back after timeout")
+        }
+        if len(pc.list()) != 0 {
+            t.Errorf("got pending %v after rollback", pc.list())
+        }
+        reverted = nil
+    })
+
+    t.Run("restart", func(t *testing.T) {
+        confirmed := commit(time.Minute)
+        expiring := commit(300 * time.Millisecond)
+        files, _ := filepath.Glob(filepath.Join(dir, "pending_commits", "*.json"))
+        if len(files) != 2 {
+            t.Fatalf("got files %v, want one per pending commit", files)
+        }
+        pending := pc.list()
+        // Stop the timers of the commits as if the server stopped
+        pc.mu.Lock()
+        for _, c := range pc.commits {
+            c.timer.Stop()
+        }
+        pc.mu.Unlock()
+
+        restarted := newPendingCommits()
+        hook(restarted)
+        restarted.load()
+        if diff := pretty.Compare(pending, restarted.list()); diff != "" {
+            t.Fatalf("unexpected commits loaded (-want +got):\n%s", diff)
+        }
+        if err := restarted.confirm(confirmed, admin); err != nil {
+            t.Fatal(err)
+        }
+        select {
+        case ns := <-revertedCh:
+            if ns != strconv.Itoa(taken) {
+                t.Errorf("got changes of checkpoint %v reverted, want %v", ns, taken)
+            }
+        case <-time.After(5 * time.Second):
+            t.Fatalf("commit %v loaded not rolled back after timeout", expiring)
+        }
+        if files, _ := filepath.Glob(filepath.Join(dir, "pending_commits", "*.json")); len(files) != 0 {
+            t.Errorf("got files %v, want none after confirm and rollback", files)
+        }
+    })
+}
+
+func TestConfigCheckpoint(t *testing.T) {
+    s := createServer(t, 8085)
+    go runServer(t, s)
+    defer s.s.Stop()
+
+    prepareConfigDb(t)
+
+    dir, err := ioutil.TempDir("", "config_checkpoint")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+    sdc.CheckpointDir = dir
+
+    tlsConfig := &tls.Config{InsecureSkipVerify: true}
+    opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+
+    targetAddr := "127.0.0.1:8085"
+    conn, err := grpc.Dial(targetAddr, opts...)
+    if err != nil {
+        t.Fatalf("Dialing to %q failed: %v", targetAddr, err)
+    }
+    defer conn.Close()
+
+    sc := sgpb.NewSonicServiceClient(conn)
+    ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+    defer cancel()
+
+    rclient := getConfigDbClient(t)
+    defer rclient.Close()
+
+    t.Run("Checkpoint", func(t *testing.T) {
+        resp, err := sc.Checkpoint(ctx, &sgpb.CheckpointRequest{Name: "base"})
+        if err != nil {
+            t.Fatal(err.Error())
+        }
+        if resp.NumEntries == 0 {
+            t.Fatalf("Saved no entries of CONFIG_DB")
+        }
+        list, err := sc.ListCheckpoints(ctx, &sgpb.ListCheckpointsRequest{})
+        if err != nil {
+            t.Fatal(err.Error())
+        }
+        if len(list.Checkpoints) != 1 || list.Checkpoints[0].Name != "base" ||
+            list.Checkpoints[0].NumEntries != resp.NumEntries {
+            t.Fatalf("got checkpoints %v, want base with %v entries", list.Checkpoints, resp.NumEntries)
+        }
+    })
+
+    t.Run("InvalidName", func(t *testing.T) {
+        _, err := sc.Checkpoint(ctx, &sgpb.CheckpointRequest{Name: "../base"})
+        if status.Code(err) != codes.InvalidArgument {
+            t.Fatalf("got %v, want InvalidArgument", err)
+        }
+    })
+
+    rclient.HSet("PFC_WD_TABLE|Ethernet0", "action", "forward")
+    rclient.HSet("PFC_WD_TABLE|Ethernet0", "detection_time", "200")
+    rclient.Del("PFC_WD_TABLE|Ethernet1")
+    rclient.HSet("VLAN|Vlan10", "vlanid", "10")
+
+    t.Run("DiffCheckpoint", func(t *testing.T) {
+        resp, err := sc.DiffCheckpoint(ctx, &sgpb.DiffCheckpointRequest{Name: "base"})
+        if err != nil {
+            t.Fatal(err.Error())
+        }
+        want := []*sgpb.CheckpointDiff{
+            {
+                Table:  "PFC_WD_TABLE",
+                Key:    "Ethernet0",
+                Change: sgpb.CheckpointDiff_MODIFIED,
+                Fields: []*sgpb.CheckpointDiff_Field{
+                    {Field: "action", Change: sgpb.CheckpointDiff_MODIFIED, CheckpointValue: "drop", CurrentValue: "forward"},
+                    {Field: "detection_time", Change: sgpb.CheckpointDiff_ADDED, CurrentValue: "200"},
+                },
+            },
+            {
+                Table:  "PFC_WD_TABLE",
+                Key:    "Ethernet1",
+                Change: sgpb.CheckpointDiff_REMOVED,
+                Fields: []*sgpb.CheckpointDiff_Field{
+                    {Field: "action", Change: sgpb.CheckpointDiff_REMOVED, CheckpointValue: "drop"},
+                },
+            },
+            {
+                Table:  "VLAN",
+                Key:    "Vlan10",
+                Change: sgpb.CheckpointDiff_ADDED,
+                Fields: []*sgpb.CheckpointDiff_Field{
+                    {Field: "vlanid", Change: sgpb.CheckpointDiff_ADDED, CurrentValue: "10"},
+                },
+            },
+        }
+        if diff := pretty.Compare(want, resp.Entries); diff != "" {
+            t.Errorf("unexpected diff of checkpoint (-want +got):\n%s", diff)
+        }
+    })
+
+    t.Run("Rollback", func(t *testing.T) {
+        resp, err := sc.Rollback(ctx, &sgpb.RollbackRequest{Name: "base"})
+        if err != nil {
+            t.Fatal(err.Error())
+        }
+        if resp.NumEntries != 3 {
+            t.Errorf("Rewrote %d entries, want 3", resp.NumEntries)
+        }
+        diff, err := sc.DiffCheckpoint(ctx, &sgpb.DiffCheckpointRequest{Name: "base"})
+        if err != nil {
+            t.Fatal(err.Error())
+        }
+        if len(diff.Entries) != 0 {
+            t.Errorf("got diff %v after rollback, want none", diff.Entries)
+        }
+    })
+
+    t.Run("UnknownCheckpoint", func(t *testing.T) {
+        _, err := sc.Rollback(ctx, &sgpb.RollbackRequest{Name: "unknown"})
+        if status.Code(err) != codes.NotFound {
+            t.Fatalf("got %v, want NotFound", err)
+        }
+    })
+}
+
+// TestConfigCheckpointAuth checks only admins take and roll back to
+// checkpoints
+func TestConfigCheckpointAuth(t *testing.T) {
+    s := createServer(t, 8090)
+    s.config.UserAuth = AuthTypes{"jwt": true}
+    go runServer(t, s)
+    defer s.s.Stop()
+
+    prepareConfigDb(t)
+
+    dir, err := ioutil.TempDir("", "config_checkpoint")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+    sdc.CheckpointDir = dir
+
+    tlsConfig := &tls.Config{InsecureSkipVerify: true}
+    opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+
+    targetAddr := "127.0.0.1:8090"
+    conn, err := grpc.Dial(targetAddr, opts...)
+    if err != nil {
+        t.Fatalf("Dialing to %q failed: %v", targetAddr, err)
+    }
+    defer conn.Close()
+
+    sc := sgpb.NewSonicServiceClient(conn)
+    ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+    defer cancel()
+    userCtx := func(user string, roles ...string) context.Context {
+        token := generateJWT(user, roles, time.Now().Add(time.Minute))
+        return metadata.AppendToOutgoingContext(ctx, "access_token", token)
+    }
+    adminCtx := userCtx("admin", "admin")
+    operatorCtx := userCtx("guest", "operator")
+
+    if _, err := sc.Checkpoint(adminCtx, &sgpb.CheckpointRequest{Name: "base"}); err != nil {
+        t.Fatal(err.Error())
+    }
+
+    t.Run("NotAdmin", func(t *testing.T) {
+        _, err := sc.Checkpoint(operatorCtx, &sgpb.CheckpointRequest{Name: "other"})
+        if status.Code(err) != codes.PermissionDenied {
+            t.Errorf("got %v taking checkpoint, want PermissionDenied", err)
+        }
+        _, err = sc.Rollback(operatorCtx, &sgpb.RollbackRequest{Name: "base"})
+        if status.Code(err) != codes.PermissionDenied {
+            t.Errorf("got %v rolling back, want PermissionDenied", err)
+        }
+        list, err := sc.ListCheckpoints(operatorCtx, &sgpb.ListCheckpointsRequest{})
+        if err != nil {
+            t.Fatal(err.Error())
+        }
+        if len(list.Checkpoints) != 1 || list.Checkpoints[0].Name != "base" {
+            t.Errorf("got checkpoints %v, want base only", list.Checkpoints)
+        }
+    })
+
+    t.Run("Admin", func(t *testing.T) {
+        if _, err := sc.Rollback(adminCtx, &sgpb.RollbackRequest{Name: "base"}); err != nil {
+            t.Fatal(err.Error())
+        }
+    })
+}
+
+// TestNamespaces reads DB targets of the namespaces of a multi-ASIC layout
+func TestNamespaces(t *testing.T) {
+    if err := sdc.LoadDbConfig("testdata/database_global.json"); err != nil {
+        t.Fatal(err)
+    }
+    defer sdc.LoadDbConfig("testdata/database_config.json")
+
+    s := createServer(t, 8086)
+    go runServer(t, s)
+    defer s.s.Stop()
+
+    // PORT_TABLE:Ethernet0 of APPL_DB, DB 0 of the host, DB 8 of asic0
+    for dbn, mtu := range map[int]string{0: "9100", 8: "1500"} {
+        rclient := redis.NewClient(&redis.Options{Network: "tcp", Addr: "localhost:6379", DB: dbn})
+        rclient.FlushDB()
+        rclient.HSet("PORT_TABLE:Ethernet0", "mtu", mtu)
+        rclient.Close()
+    }
+
+    tlsConfig := &tls.Config{InsecureSkipVerify: true}
+    opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+    targetAddr := "127.0.0.1:8086"
+    conn, err := grpc.Dial(targetAddr, opts...)
+    if err != nil {
+        t.Fatalf("Dialing to %q failed: %v", targetAddr, err)
+    }
+    defer conn.Close()
+    gClient := pb.NewGNMIClient(conn)
+    ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+    defer cancel()
+
+    get := func(prefixOrigin, pathOrigin string) (interface{}, error) {
+        req := &pb.GetRequest{
+            Prefix: &pb.Path{Target: "APPL_DB", Origin: prefixOrigin},
+            Path: []*pb.Path{{
+                Origin: pathOrigin,
+                Elem:   []*pb.PathElem{{Name: "PORT_TABLE"}, {Name: "Ethernet0"}},
+            }},
+            Encoding: pb.Encoding_JSON_IETF,
+        }
+        resp, err := gClient.Get(ctx, req)
+        if err != nil {
+            return nil, err
+        }
+        var val interface{}
+        jv := resp.GetNotification()[0].GetUpdate()[0].GetVal().GetJsonIetfVal()
+        if err := json.Unmarshal(jv, &val); err != nil {
+            t.Fatalf("invalid JSON %s: %v", jv, err)
+        }
+        return val, nil
+    }
+
+    tests := []struct {
+        desc         string
+        prefixOrigin string
+        pathOrigin   string
+        want         interface{}
+    }{
+        {"host by default", "", "", map[string]interface{}{"mtu": "9100"}},
+        {"asic0 by path origin", "", "asic0", map[string]interface{}{"mtu": "1500"}},
+        {"asic0 by prefix origin", "asic0", "", map[string]interface{}{"mtu": "1500"}},
+        {"path origin over prefix origin", "asic0", "*", map[string]interface{}{
+            "host":  map[string]interface{}{"mtu": "9100"},
+            "asic0": map[string]interface{}{"mtu": "1500"},
+        }},
+        {"all namespaces", "*", "", map[string]interface{}{
+            "host":  map[string]interface{}{"mtu": "9100"},
+            "asic0": map[string]interface{}{"mtu": "1500"},
+        }},
+    }
+    for _, tt := range tests {
+        t.Run(tt.desc, func(t *testing.T) {
+            got, err := get(tt.prefixOrigin, tt.pathOrigin)
+            if err != nil {
+                t.Fatalf("Get failed: %v", err)
+            }
+            if diff := pretty.Compare(tt.want, got); diff != "" {
+                t.Errorf("unexpected value (-want +got):\n%s", diff)
+            }
+        })
+    }
+
+    t.Run("unknown namespace", func(t *testing.T) {
+        if _, err := get("", "asic9"); status.Code(err) != codes.InvalidArgument {
+            t.Errorf("got %v, want InvalidArgument", err)
+        }
+    })
+}
+
+func TestNameMapRefresh(t *testing.T) {
+    s := createServer(t, 8087)
+    go runServer(t, s)
+    defer s.s.Stop()
+
+    prepareDb(t)
+
+    tlsConfig := &tls.Config{InsecureSkipVerify: true}
+    opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+    targetAddr := "127.0.0.1:8087"
+    conn, err := grpc.Dial(targetAddr, opts...)
+    if err != nil {
+        t.Fatalf("Dialing to %q failed: %v", targetAddr, err)
+    }
+    defer conn.Close()
+    gClient := pb.NewGNMIClient(conn)
+    ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+    defer cancel()
+
+    ethAll := &pb.Path{Elem: []*pb.PathElem{{Name: "COUNTERS"}, {Name: "Ethernet*"}}}
+    prefix := &pb.Path{Target: "COUNTERS_DB"}
+    // Ports in the value of COUNTERS/Ethernet*, by vendor alias if any
+    ports := func(jv []byte) map[string]bool {
+        var val map[string]interface{}
+        if err := json.Unmarshal(jv, &val); err != nil {
+            t.Fatalf("invalid JSON %s: %v", jv, err)
+        }
+        ports := make(map[string]bool)
+        for port := range val {
+            ports[port] = true
+        }
+        return ports
+    }
+    getPorts := func() map[string]bool {
+        req := &pb.GetRequest{Prefix: prefix, Path: []*pb.Path{ethAll}, Encoding: pb.Encoding_JSON_IETF}
+        resp, err := gClient.Get(ctx, req)
+        if err != nil {
+            t.Fatalf("Get failed: %v", err)
+        }
+        return ports(resp.GetNotification()[0].GetUpdate()[0].GetVal().GetJsonIetfVal())
+    }
+    // Wait for the name maps to be refreshed upon change notification
+    waitPorts := func(port string, want bool) {
+        deadline := time.Now().Add(10 * time.Second)
+        for getPorts()[port] != want {
+            if time.Now().After(deadline) {
+                t.Fatalf("%v in COUNTERS/Ethernet* is %v, want %v", port, !want, want)
+            }
+            time.Sleep(200 * time.Millisecond)
+        }
+    }
+
+    rclient := getRedisClient(t)
+    defer rclient.Close()
+    // Keyspace notifications the name map watcher subscribes to
+    notifyPortNameMap := func(event string) {
+        dbn := spb.Target_value["COUNTERS_DB"]
+        rclient.Publish(fmt.Sprintf("__keyspace@%d__:COUNTERS_PORT_NAME_MAP", dbn), event)
+    }
+
+    if !getPorts()["Ethernet68/1"] {
+        t.Fatalf("Ethernet68/1 not found in COUNTERS/Ethernet*: %v", getPorts())
+    }
+
+    // A live wildcard subscription, which is expanded again upon refresh
+    stream, err := gClient.Subscribe(ctx)
+    if err != nil {
+        t.Fatalf("Subscribe failed: %v", err)
+    }
+    err = stream.Send(&pb.SubscribeRequest{
+        Request: &pb.SubscribeRequest_Subscribe{
+            Subscribe: &pb.SubscriptionList{
+                Prefix:       prefix,
+                Mode:         pb.SubscriptionList_STREAM,
+                Encoding:     pb.Encoding_JSON_IETF,
+                Subscription: []*pb.Subscription{{Path: ethAll, Mode: pb.SubscriptionMode_ON_CHANGE}},
+            },
+        },
+    })
+    if err != nil {
+        t.Fatalf("Subscribe failed: %v", err)
+    }
+    // recvPorts returns ports of the next update, skipping sync response
+    recvPorts := func() map[string]bool {
+        for {
+            resp, err := stream.Recv()
+            if err != nil {
+                t.Fatalf("Recv failed: %v", err)
+            }
+            if resp.GetSyncResponse() {
+                continue
+            }
+            for _, u := range resp.GetUpdate().GetUpdate() {
+                return ports(u.GetVal().GetJsonIetfVal())
+            }
+        }
+    }
+    if got := recvPorts(); !got["Ethernet68/1"] || got["Ethernet200"] {
+        t.Fatalf("initial update of COUNTERS/Ethernet* has ports %v", got)
+    }
+
+    t.Run("PortAdded", func(t *testing.T) {
+        rclient.HSet("COUNTERS:oid:0x10000000000c8", "SAI_PORT_STAT_IF_IN_OCTETS", "200")
+        rclient.HSet("COUNTERS_PORT_NAME_MAP", "Ethernet200", "oid:0x10000000000c8")
+        notifyPortNameMap("hset")
+        waitPorts("Ethernet200", true)
+    })
+
+    t.Run("SubscriptionExpanded", func(t *testing.T) {
+        for {
+            got := recvPorts()
+            if got["Ethernet200"] {
+                if !got["Ethernet68/1"] {
+                    t.Errorf("Ethernet68/1 missing from update of COUNTERS/Ethernet* after refresh: %v", got)
+                }
+                break
+            }
+        }
+    })
+
+    t.Run("PortRemoved", func(t *testing.T) {
+        rclient.HDel("COUNTERS_PORT_NAME_MAP", "Ethernet200")
+        rclient.Del("COUNTERS:oid:0x10000000000c8")
+        notifyPortNameMap("hdel")
+        waitPorts("Ethernet200", false)
+        if !getPorts()["Ethernet68/1"] {
+            t.Error("Ethernet68/1 not found in COUNTERS/Ethernet* after refresh")
+        }
+    })
+}
+
+func TestSubscriptionCache(t *testing.T) {
+    s := createServer(t, 8088)
+    go runServer(t, s)
+    defer s.s.Stop()
+
+    // PORT_TABLE of APPL_DB, DB 0
+    rclient := redis.NewClient(&redis.Options{Network: "tcp", Addr: "localhost:6379", DB: 0})
+    defer rclient.Close()
+    rclient.FlushDB()
+    rclient.HSet("PORT_TABLE:Ethernet0", "mtu", "9100")
+    rclient.HSet("PORT_TABLE:Ethernet4", "mtu", "9100")
+    // Keyspace notification of a change of the table
+    notify := func(key string) {
+        rclient.Publish("__keyspace@0__:PORT_TABLE:"+key, "hset")
+    }
+
+    tlsConfig := &tls.Config{InsecureSkipVerify: true}
+    opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+    targetAddr := "127.0.0.1:8088"
+    conn, err := grpc.Dial(targetAddr, opts...)
+    if err != nil {
+        t.Fatalf("Dialing to %q failed: %v", targetAddr, err)
+    }
+    defer conn.Close()
+    gClient := pb.NewGNMIClient(conn)
+    ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+    defer cancel()
+
+    prefix := &pb.Path{Target: "APPL_DB"}
+    path := &pb.Path{Elem: []*pb.PathElem{{Name: "PORT_TABLE"}}}
+    full := map[string]interface{}{
+        "Ethernet0": map[string]interface{}{"mtu": "9100"},
+        "Ethernet4": map[string]interface{}{"mtu": "9100"},
+    }
+    decode := func(jv []byte) interface{} {
+        var val interface{}
+        if err := json.Unmarshal(jv, &val); err != nil {
+            t.Fatalf("invalid JSON %s: %v", jv, err)
+        }
+        return val
+    }
+
+    type subscription struct {
+        stream pb.GNMI_SubscribeClient
+        conn   *grpc.ClientConn
+    }
+    // Each subscription has its own connection, the server keeping one
+    // subscription per client address
+    subscribe := func() *subscription {
+        conn, err := grpc.Dial(targetAddr, opts...)
+        if err != nil {
+            t.Fatalf("Dialing to %q failed: %v", targetAddr, err)
+        }
+        stream, err := pb.NewGNMIClient(conn).Subscribe(ctx)
+        if err != nil {
+            t.Fatalf("Subscribe failed: %v", err)
+        }
+        err = stream.Send(&pb.SubscribeRequest{
+            Request: &pb.SubscribeRequest_Subscribe{
+                Subscribe: &pb.SubscriptionList{
+                    Prefix:       prefix,
+                    Mode:         pb.SubscriptionList_STREAM,
+                    Encoding:     pb.Encoding_JSON_IETF,
+                    Subscription: []*pb.Subscription{{Path: path, Mode: pb.SubscriptionMode_ON_CHANGE}},
+                },
+            },
+        })
+        if err != nil {
+            t.Fatalf("Subscribe failed: %v", err)
+        }
+        return &subscription{stream, conn}
+    }
+    // recv returns the value of the next update, nil for sync response
+    recv := func(sub *subscription) interface{} {
+        resp, err := sub.stream.Recv()
+        if err != nil {
+            t.Fatalf("Recv failed: %v", err)
+        }
+        if resp.GetSyncResponse() {
+            return nil
+        }
+        return decode(resp.GetUpdate().GetUpdate()[0].GetVal().GetJsonIetfVal())
+    }
+    // expectInitial checks the initial value and sync response of sub
+    expectInitial := func(sub *subscription, want interface{}) {
+        if diff := pretty.Compare(want, recv(sub)); diff != "" {
+            t.Fatalf("unexpected initial value (-want +got):\n%s", diff)
+        }
+        if val := recv(sub); val != nil {
+            t.Fatalf("got %v, want sync response", val)
+        }
+    }
+    get := func() interface{} {
+        req := &pb.GetRequest{Prefix: prefix, Path: []*pb.Path{path}, Encoding: pb.Encoding_JSON_IETF}
+        resp, err := gClient.Get(ctx, req)
+        if err != nil {
+            t.Fatalf("Get failed: %v", err)
+        }
+        return decode(resp.GetNotification()[0].GetUpdate()[0].GetVal().GetJsonIetfVal())
+    }
+    feeds := func() int {
+        s.cache.mu.Lock()
+        defer s.cache.mu.Unlock()
+        return len(s.cache.feeds)
+    }
+
+    sub1 := subscribe()
+    defer sub1.conn.Close()
+    expectInitial(sub1, full)
+
+    t.Run("FeedShared", func(t *testing.T) {
+        sub2 := subscribe()
+        defer sub2.conn.Close()
+        expectInitial(sub2, full)
+        if n := feeds(); n != 1 {
+            t.Fatalf("%v feeds for the same subscription, want 1", n)
+        }
+    })
+
+    t.Run("GetFromCache", func(t *testing.T) {
+        // Changed without notification, not seen by the feed
+        rclient.HSet("PORT_TABLE:Ethernet4", "mtu", "1500")
+        if diff := pretty.Compare(full, get()); diff != "" {
+            t.Fatalf("Get not served from cache (-want +got):\n%s", diff)
+        }
+    })
+
+    updated := map[string]interface{}{
+        "Ethernet0": map[string]interface{}{"mtu": "9100"},
+        "Ethernet4": map[string]interface{}{"mtu": "1500"},
+    }
+    t.Run("LateJoinAfterUpdate", func(t *testing.T) {
+        notify("Ethernet4")
+        // The update streamed holds data of the changed key only
+        if val := recv(sub1).(map[string]interface{}); val["Ethernet0"] != nil {
+            t.Fatalf("got %v, want update of Ethernet4 only", val)
+        }
+
+        // A new subscription gets the full value from a new feed
+        sub3 := subscribe()
+        defer sub3.conn.Close()
+        expectInitial(sub3, updated)
+        s.cache.mu.Lock()
+        n := len(s.cache.paths)
+        for _, fs := range s.cache.paths {
+            n = len(fs)
+        }
+        s.cache.mu.Unlock()
+        if n != 2 {
+            t.Fatalf("%v feeds running, want 2", n)
+        }
+
+        // Get is served by the new feed, not the outdated one
+        if diff := pretty.Compare(updated, get()); diff != "" {
+            t.Fatalf("unexpected Get value (-want +got):\n%s", diff)
+        }
+    })
+}
+
+// TestSampledFeeds checks Get is not served by feeds sampling values
+func TestSampledFeeds(t *testing.T) {
+    tests := []struct {
+        mode    pb.SubscriptionMode
+        target  string
+        sampled bool
+    }{
+        {pb.SubscriptionMode_SAMPLE, "APPL_DB", true},
+        {pb.SubscriptionMode_ON_CHANGE, "APPL_DB", false},
+        {pb.SubscriptionMode_TARGET_DEFINED, "APPL_DB", false},
+        {pb.SubscriptionMode_SAMPLE, "", true},
+        {pb.SubscriptionMode_ON_CHANGE, "", false},
+        // Sampled by translib on paths not supporting on change
+        {pb.SubscriptionMode_TARGET_DEFINED, "", true},
+        {pb.SubscriptionMode_TARGET_DEFINED, "OC_YANG", true},
+    }
+    for _, tt := range tests {
+        if got := sampledFeed(tt.mode, tt.target); got != tt.sampled {
+            t.Errorf("sampledFeed(%v, %q) = %v, want %v", tt.mode, tt.target, got, tt.sampled)
+        }
+    }
+
+    cache := newSubscriptionCache(&Config{})
+    key := feedKey{path: "/interfaces", mode: pb.SubscriptionMode_TARGET_DEFINED}
+    f := &dataFeed{
+        key:     key,
+        synced:  true,
+        sampled: true,
+        values:  map[string]*spb.Value{"/interfaces": {}},
+    }
+    cache.paths[key.path] = map[*dataFeed]bool{f: true}
+    if _, ok := cache.get(key.path); ok {
+        t.Errorf("Get served by a sampled feed")
+    }
+    f.sampled = false
+    if values, ok := cache.get(key.path); !ok || len(values) != 1 {
+        t.Errorf("got %v, %v from a feed streaming changes, want its value", values, ok)
+    }
+}
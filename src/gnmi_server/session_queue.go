@@ -0,0 +1,215 @@
+package gnmi_server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/openconfig/gnmi/coalesce"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	spb "proto"
+	sdc "sonic_data_client"
+)
+
+// Values of a stream subscription are queued for the client in a session
+// queue. When a slow client lets the queue reach its size, new values are
+// handled per SlowConsumerPolicy. Sync responses and fatal values are never
+// dropped.
+
+// SlowConsumerPolicy tells what to do with new values of a full queue
+type SlowConsumerPolicy string
+
+const (
+	// Replace the value queued for the same path. Values of paths not
+	// queued yet are still queued, the queue holding at most one value
+	// per path beyond its size.
+	PolicyCoalesce SlowConsumerPolicy = "coalesce"
+	// Drop the oldest value queued
+	PolicyDropOldest SlowConsumerPolicy = "drop_oldest"
+	// Close the subscription with ResourceExhausted
+	PolicyDisconnect SlowConsumerPolicy = "disconnect"
+)
+
+func (p *SlowConsumerPolicy) String() string {
+	return string(*p)
+}
+
+func (p *SlowConsumerPolicy) Set(s string) error {
+	switch SlowConsumerPolicy(s) {
+	case PolicyCoalesce, PolicyDropOldest, PolicyDisconnect:
+		*p = SlowConsumerPolicy(s)
+		return nil
+	}
+	return fmt.Errorf("Expecting one of '%v', '%v' or '%v'", PolicyCoalesce, PolicyDropOldest, PolicyDisconnect)
+}
+
+// Context done already, to take values from a queue without blocking
+var doneCtx context.Context
+
+func init() {
+	var cancel context.CancelFunc
+	doneCtx, cancel = context.WithCancel(context.Background())
+	cancel()
+}
+
+// A value in the queue. Its value is replaced when coalesced.
+type queuedValue struct {
+	v *spb.Value
+	// Whether v has updates, not sync response nor fatal value
+	update bool
+	// Paths of the updates
+	path string
+	// Taken from the queue already
+	taken bool
+}
+
+type sessionQueue struct {
+	mu     sync.Mutex
+	q      *coalesce.Queue
+	size   int
+	policy SlowConsumerPolicy
+	// Update queued per path
+	latest map[string]*queuedValue
+	// Values queued, oldest first, some of which may be taken already
+	order []*queuedValue
+	// Number of values queued and not taken
+	n int
+	// Set when closed for a slow client with PolicyDisconnect
+	overflow bool
+
+	// Values replaced by newer ones and values dropped
+	coalesced uint64
+	dropped   uint64
+}
+
+// newSessionQueue returns a queue holding size values before applying
+// policy, size 0 being unbounded.
+func newSessionQueue(size int, policy SlowConsumerPolicy) *sessionQueue {
+	if policy == "" {
+		policy = PolicyCoalesce
+	}
+	return &sessionQueue{
+		q:      coalesce.NewQueue(),
+		size:   size,
+		policy: policy,
+		latest: make(map[string]*queuedValue),
+	}
+}
+
+// put queues v, applying the policy if the queue is full
+func (sq *sessionQueue) put(v *spb.Value) {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+	if sq.q.IsClosed() {
+		return
+	}
+	qv := &queuedValue{v: v}
+	if !v.GetSyncResponse() && v.GetFatal() == "" {
+		qv.update = true
+		qv.path = updatePaths(v)
+	}
+
+	if qv.update && sq.size > 0 && sq.n >= sq.size {
+		switch sq.policy {
+		case PolicyCoalesce:
+			if old, ok := sq.latest[qv.path]; ok && len(v.GetDeletes()) == 0 {
+				old.v = v
+				sq.q.Insert(old)
+				sq.coalesced++
+				return
+			}
+		case PolicyDropOldest:
+			sq.dropOldest()
+		case PolicyDisconnect:
+			log.V(1).Infof("Session queue full with %v values, disconnecting", sq.n)
+			sq.overflow = true
+			sq.q.Close()
+			return
+		}
+	}
+	// Deletes are not to be lost by coalescing
+	if qv.update && len(v.GetDeletes()) == 0 {
+		sq.latest[qv.path] = qv
+	}
+	sq.order = append(sq.order, qv)
+	sq.n++
+	sq.q.Insert(qv)
+}
+
+// updatePaths returns the paths of all updates of v
+func updatePaths(v *spb.Value) string {
+	var paths []string
+	for _, u := range sdc.ValueUpdates(v) {
+		paths = append(paths, proto.CompactTextString(u.GetPath()))
+	}
+	return strings.Join(paths, "|")
+}
+
+// dropOldest drops the oldest update queued, leaving sync responses and
+// fatal values in place. The update dropped is left in the queue as taken.
+// It is called with mu held.
+func (sq *sessionQueue) dropOldest() {
+	for _, qv := range sq.order {
+		if !qv.taken && qv.update {
+			sq.take(qv)
+			sq.dropped++
+			return
+		}
+	}
+}
+
+// take marks qv as taken from the queue. It is called with mu held.
+func (sq *sessionQueue) take(qv *queuedValue) {
+	qv.taken = true
+	sq.n--
+	if sq.latest[qv.path] == qv {
+		delete(sq.latest, qv.path)
+	}
+	for len(sq.order) > 0 && sq.order[0].taken {
+		sq.order[0] = nil
+		sq.order = sq.order[1:]
+	}
+}
+
+// next waits for the next value. An error is returned once the queue is
+// closed and empty, or at once if closed for overflow.
+func (sq *sessionQueue) next(ctx context.Context) (*spb.Value, error) {
+	for {
+		i, _, err := sq.q.Next(ctx)
+		sq.mu.Lock()
+		if sq.overflow {
+			sq.mu.Unlock()
+			return nil, grpc.Errorf(codes.ResourceExhausted, "client too slow, session queue of %v values full", sq.size)
+		}
+		if err != nil {
+			sq.mu.Unlock()
+			return nil, err
+		}
+		qv := i.(*queuedValue)
+		if qv.taken {
+			// Queued again while coalesced after being taken
+			sq.mu.Unlock()
+			continue
+		}
+		sq.take(qv)
+		v := qv.v
+		sq.mu.Unlock()
+		return v, nil
+	}
+}
+
+// close closes the queue, values queued are still returned by next
+func (sq *sessionQueue) close() {
+	sq.q.Close()
+}
+
+// stats returns the number of values coalesced and dropped
+func (sq *sessionQueue) stats() (coalesced uint64, dropped uint64) {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+	return sq.coalesced, sq.dropped
+}
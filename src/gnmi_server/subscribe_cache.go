@@ -0,0 +1,412 @@
+package gnmi_server
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"common_utils"
+	"github.com/Workiva/go-datastructures/queue"
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+	"golang.org/x/net/context"
+	spb "proto"
+	sdc "sonic_data_client"
+)
+
+// Stream subscriptions of all clients share one data feed per distinct
+// path and subscription options. A feed runs the data client of its path
+// once, caches the latest value of each update path and fans out values to
+// all subscriptions. A new subscription gets the cached values at once.
+// Get requests are served from the cache of synced feeds on their path.
+// Data clients may stream changes only of the value of a path once
+// synced, which are not merged into the cache. A feed of such a client is
+// not shared anymore after such a change, a new subscription starting a
+// new feed instead.
+
+// Key of a path queried by a user
+type cachePathKey string
+
+type feedKey struct {
+	path              cachePathKey
+	mode              gnmipb.SubscriptionMode
+	sampleInterval    uint64
+	suppressRedundant bool
+	heartbeatInterval uint64
+}
+
+type dataFeed struct {
+	key  feedKey
+	dc   sdc.Client
+	q    *queue.PriorityQueue
+	stop chan struct{}
+	w    sync.WaitGroup
+
+	// Whether values after sync may be partial
+	partial bool
+	// Whether values are sampled, outdated up to a sample interval
+	sampled bool
+
+	// Protected by mu of the cache
+	// Latest value per update path
+	values map[string]*spb.Value
+	// Keys of cached leaves per path of flattened values
+	leaves   map[string]map[string]bool
+	synced   bool
+	fatal    *spb.Value
+	sessions map[*cacheSession]bool
+	// Whether values cached are incomplete, with partial values
+	// received after sync
+	outdated bool
+	stopped  bool
+}
+
+// Subscription of a client on feeds
+type cacheSession struct {
+	q *sessionQueue
+	// Number of feeds not synced yet
+	pending int
+	feeds   []*dataFeed
+}
+
+type subscriptionCache struct {
+	mu    sync.Mutex
+	feeds map[feedKey]*dataFeed
+	// Feeds per path
+	paths map[cachePathKey]map[*dataFeed]bool
+	// Size and policy of session queues
+	queueSize int
+	policy    SlowConsumerPolicy
+	// Whether JSON values are flattened to leaves
+	flatten bool
+}
+
+func newSubscriptionCache(config *Config) *subscriptionCache {
+	return &subscriptionCache{
+		feeds:     make(map[feedKey]*dataFeed),
+		paths:     make(map[cachePathKey]map[*dataFeed]bool),
+		queueSize: config.QueueSize,
+		policy:    config.SlowConsumerPolicy,
+		flatten:   config.FlattenJSON,
+	}
+}
+
+// pathKey returns the key of path with prefix queried by the user of ctx.
+// Data is not shared among users as authorization may differ.
+func pathKey(ctx context.Context, prefix, path *gnmipb.Path) cachePathKey {
+	rc, _ := common_utils.GetContext(ctx)
+	return cachePathKey(fmt.Sprintf("%s|%s|%s", rc.Auth.User, proto.CompactTextString(prefix), proto.CompactTextString(path)))
+}
+
+// put puts v to the queue of session s
+func (s *cacheSession) put(v *spb.Value) {
+	s.q.put(v)
+}
+
+// putSync tells session s all its feeds have been synced
+func (s *cacheSession) putSync() {
+	s.put(&spb.Value{
+		Timestamp:    time.Now().UnixNano(),
+		SyncResponse: true,
+	})
+}
+
+// subscribe attaches a new session to the feeds of subscriptions in
+// sublist, starting the feeds not running yet.
+func (cache *subscriptionCache) subscribe(ctx context.Context, sublist *gnmipb.SubscriptionList) (*cacheSession, error) {
+	s := &cacheSession{q: newSessionQueue(cache.queueSize, cache.policy)}
+	prefix := sublist.GetPrefix()
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	for _, sub := range sublist.GetSubscription() {
+		key := feedKey{
+			path:              pathKey(ctx, prefix, sub.GetPath()),
+			mode:              sub.GetMode(),
+			sampleInterval:    sub.GetSampleInterval(),
+			suppressRedundant: sub.GetSuppressRedundant(),
+			heartbeatInterval: sub.GetHeartbeatInterval(),
+		}
+		f, ok := cache.feeds[key]
+		if !ok || f.outdated {
+			var err error
+			if f, err = cache.startFeed(ctx, key, prefix, sub); err != nil {
+				cache.unsubscribeLocked(s)
+				return nil, err
+			}
+		}
+		f.sessions[s] = true
+		s.feeds = append(s.feeds, f)
+		for _, v := range f.values {
+			s.put(v)
+		}
+		if f.fatal != nil {
+			s.put(f.fatal)
+		}
+		if !f.synced {
+			s.pending++
+		}
+	}
+	if s.pending == 0 {
+		s.putSync()
+	}
+	return s, nil
+}
+
+// unsubscribe detaches session s from its feeds, stopping the feeds
+// without any session left.
+func (cache *subscriptionCache) unsubscribe(s *cacheSession) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.unsubscribeLocked(s)
+}
+
+func (cache *subscriptionCache) unsubscribeLocked(s *cacheSession) {
+	for _, f := range s.feeds {
+		delete(f.sessions, s)
+		if len(f.sessions) == 0 {
+			cache.stopFeed(f)
+		}
+	}
+	s.feeds = nil
+	s.q.close()
+}
+
+// startFeed runs the data client of the subscription with prefix for key.
+// It is called with mu held.
+func (cache *subscriptionCache) startFeed(ctx context.Context, key feedKey, prefix *gnmipb.Path, sub *gnmipb.Subscription) (*dataFeed, error) {
+	// The feed may outlive the subscription creating it
+	ctx = common_utils.DetachContext(ctx)
+	dc, err := newDataClient(ctx, prefix, []*gnmipb.Path{sub.GetPath()})
+	if err != nil {
+		return nil, err
+	}
+	pu, ok := dc.(sdc.PartialUpdater)
+	f := &dataFeed{
+		key:      key,
+		dc:       dc,
+		partial:  ok && pu.PartialUpdates(),
+		sampled:  sampledFeed(key.mode, prefix.GetTarget()),
+		q:        queue.NewPriorityQueue(1, false),
+		stop:     make(chan struct{}, 1),
+		values:   make(map[string]*spb.Value),
+		leaves:   make(map[string]map[string]bool),
+		sessions: make(map[*cacheSession]bool),
+	}
+	// Replaces an outdated feed of key if any, which runs on until its
+	// sessions are gone
+	cache.feeds[key] = f
+	if _, ok := cache.paths[key.path]; !ok {
+		cache.paths[key.path] = make(map[*dataFeed]bool)
+	}
+	cache.paths[key.path][f] = true
+
+	sublist := &gnmipb.SubscriptionList{
+		Prefix:       prefix,
+		Subscription: []*gnmipb.Subscription{sub},
+		Mode:         gnmipb.SubscriptionList_STREAM,
+	}
+	f.w.Add(1)
+	go dc.StreamRun(f.q, f.stop, &f.w, sublist)
+	go cache.runFeed(f)
+	log.V(2).Infof("Started feed %v", key)
+	return f, nil
+}
+
+// sampledFeed tells whether a feed in mode on target samples values.
+// Clients of targets other than DBs sample TARGET_DEFINED subscriptions
+// on paths not supporting on change.
+func sampledFeed(mode gnmipb.SubscriptionMode, target string) bool {
+	switch mode {
+	case gnmipb.SubscriptionMode_SAMPLE:
+		return true
+	case gnmipb.SubscriptionMode_TARGET_DEFINED:
+		return !isTargetDb(target)
+	}
+	return false
+}
+
+// stopFeed stops feed f and removes it from the cache. It is called with
+// mu held.
+func (cache *subscriptionCache) stopFeed(f *dataFeed) {
+	if f.stopped {
+		return
+	}
+	f.stopped = true
+	if cache.feeds[f.key] == f {
+		delete(cache.feeds, f.key)
+	}
+	delete(cache.paths[f.key.path], f)
+	if len(cache.paths[f.key.path]) == 0 {
+		delete(cache.paths, f.key.path)
+	}
+	close(f.stop)
+	f.q.Dispose()
+	log.V(2).Infof("Stopped feed %v", f.key)
+}
+
+// runFeed fans out values from the data client of f until it is stopped
+func (cache *subscriptionCache) runFeed(f *dataFeed) {
+	for {
+		items, err := f.q.Get(1)
+		if items == nil {
+			log.V(2).Infof("Feed %v exits: %v", f.key, err)
+			break
+		}
+		v, ok := items[0].(sdc.Value)
+		if !ok {
+			log.V(1).Infof("Unknown data type %v for feed %v", items[0], f.key)
+			continue
+		}
+		cache.publish(f, v.Value)
+	}
+	f.w.Wait()
+	f.dc.Close()
+}
+
+// publish caches value v of feed f and fans it out to the sessions
+func (cache *subscriptionCache) publish(f *dataFeed, v *spb.Value) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	switch {
+	case v.GetFatal() != "":
+		f.fatal = v
+		for s := range f.sessions {
+			s.put(v)
+		}
+		// New subscriptions start over with a new feed
+		cache.stopFeed(f)
+	case v.GetSyncResponse():
+		if f.synced {
+			return
+		}
+		f.synced = true
+		for s := range f.sessions {
+			if s.pending--; s.pending == 0 {
+				s.putSync()
+			}
+		}
+	case cache.flatten:
+		cache.checkPartial(f)
+		if v = flattenFeedValue(f, v); v == nil {
+			return
+		}
+		for s := range f.sessions {
+			s.put(v)
+		}
+	default:
+		cache.checkPartial(f)
+		deleteCached(f, v.GetDeletes())
+		if len(v.GetUpdates()) == 0 && len(v.GetDeletes()) == 0 {
+			f.values[proto.CompactTextString(v.GetPath())] = v
+		} else {
+			// Values are cached per path
+			for _, u := range sdc.ValueUpdates(v) {
+				f.values[proto.CompactTextString(u.GetPath())] = &spb.Value{
+					Prefix:    v.GetPrefix(),
+					Path:      u.GetPath(),
+					Timestamp: v.GetTimestamp(),
+					Val:       u.GetVal(),
+				}
+			}
+		}
+		for s := range f.sessions {
+			s.put(v)
+		}
+	}
+}
+
+// checkPartial marks the cache of f outdated upon a value after sync if
+// values of f may be partial. It is called with mu held.
+func (cache *subscriptionCache) checkPartial(f *dataFeed) {
+	if f.partial && f.synced && !f.outdated {
+		f.outdated = true
+		log.V(2).Infof("Feed %v not shared anymore after a partial value", f.key)
+	}
+}
+
+// flattenFeedValue returns leaf updates of v for feed f, caching them in
+// place of the previous leaves of the same paths. With suppress_redundant
+// only changed leaves are returned, or all of them if none changed, v
+// being a heartbeat then. It is called with mu held.
+func flattenFeedValue(f *dataFeed, v *spb.Value) *spb.Value {
+	deleteCached(f, v.GetDeletes())
+	var all, changed []*gnmipb.Update
+	for _, u := range sdc.ValueUpdates(v) {
+		leaves, ok := sdc.FlattenUpdate(u)
+		if !ok {
+			leaves = []*gnmipb.Update{u}
+		}
+		pathKey := proto.CompactTextString(u.GetPath())
+		keys := make(map[string]bool)
+		for _, leaf := range leaves {
+			key := proto.CompactTextString(leaf.GetPath())
+			keys[key] = true
+			if old, ok := f.values[key]; !ok || !proto.Equal(old.GetVal(), leaf.GetVal()) {
+				changed = append(changed, leaf)
+			}
+			f.values[key] = &spb.Value{
+				Prefix:    v.GetPrefix(),
+				Path:      leaf.GetPath(),
+				Timestamp: v.GetTimestamp(),
+				Val:       leaf.GetVal(),
+			}
+		}
+		// Leaves gone with the new value
+		for key := range f.leaves[pathKey] {
+			if !keys[key] {
+				delete(f.values, key)
+			}
+		}
+		f.leaves[pathKey] = keys
+		all = append(all, leaves...)
+	}
+
+	updates := all
+	if f.key.suppressRedundant && len(changed) != 0 {
+		updates = changed
+	}
+	if len(updates) == 0 && len(v.GetDeletes()) == 0 {
+		return nil
+	}
+	return &spb.Value{
+		Prefix:    v.GetPrefix(),
+		Timestamp: v.GetTimestamp(),
+		Updates:   updates,
+		Deletes:   v.GetDeletes(),
+	}
+}
+
+// deleteCached removes cached values of f under paths deleted. It is
+// called with mu held.
+func deleteCached(f *dataFeed, deletes []*gnmipb.Path) {
+	for _, d := range deletes {
+		for key, v := range f.values {
+			if sdc.PathHasPrefix(v.GetPath(), d) {
+				delete(f.values, key)
+			}
+		}
+	}
+}
+
+// get returns the cached values of path key from a synced feed. Feeds
+// sampling values are not used as their values may be outdated, nor
+// feeds with suppress_redundant unless flattened, or with partial values
+// after sync, as their values may be partial.
+func (cache *subscriptionCache) get(key cachePathKey) ([]*spb.Value, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	for f := range cache.paths[key] {
+		if !f.synced || f.fatal != nil || f.outdated || f.sampled ||
+			(f.key.suppressRedundant && !cache.flatten) {
+			continue
+		}
+		values := make([]*spb.Value, 0, len(f.values))
+		for _, v := range f.values {
+			values = append(values, v)
+		}
+		return values, true
+	}
+	return nil, false
+}
@@ -15,6 +15,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 )
 
@@ -29,6 +30,32 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type CheckpointDiff_Change int32
+
+const (
+	CheckpointDiff_MODIFIED CheckpointDiff_Change = 0
+	// In CONFIG_DB, not in the checkpoint
+	CheckpointDiff_ADDED CheckpointDiff_Change = 1
+	// In the checkpoint, not in CONFIG_DB
+	CheckpointDiff_REMOVED CheckpointDiff_Change = 2
+)
+
+var CheckpointDiff_Change_name = map[int32]string{
+	0: "MODIFIED",
+	1: "ADDED",
+	2: "REMOVED",
+}
+
+var CheckpointDiff_Change_value = map[string]int32{
+	"MODIFIED": 0,
+	"ADDED":    1,
+	"REMOVED":  2,
+}
+
+func (CheckpointDiff_Change) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{38, 0}
+}
+
 type SonicOutput struct {
 	Status       int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	StatusDetail string `protobuf:"bytes,2,opt,name=status_detail,json=statusDetail,proto3" json:"status-detail" xml:",comment"`
@@ -1391,746 +1418,1415 @@ func (m *RefreshResponse) GetToken() *JwtToken {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*SonicOutput)(nil), "gnoi.sonic.SonicOutput")
-	proto.RegisterType((*TechsupportRequest)(nil), "gnoi.sonic.TechsupportRequest")
-	proto.RegisterType((*TechsupportRequest_Input)(nil), "gnoi.sonic.TechsupportRequest.Input")
-	proto.RegisterType((*TechsupportResponse)(nil), "gnoi.sonic.TechsupportResponse")
-	proto.RegisterType((*TechsupportResponse_Output)(nil), "gnoi.sonic.TechsupportResponse.Output")
-	proto.RegisterType((*ClearNeighborsRequest)(nil), "gnoi.sonic.ClearNeighborsRequest")
-	proto.RegisterType((*ClearNeighborsRequest_Input)(nil), "gnoi.sonic.ClearNeighborsRequest.Input")
-	proto.RegisterType((*ClearNeighborsResponse)(nil), "gnoi.sonic.ClearNeighborsResponse")
-	proto.RegisterType((*ClearNeighborsResponse_Output)(nil), "gnoi.sonic.ClearNeighborsResponse.Output")
-	proto.RegisterType((*SumRequest)(nil), "gnoi.sonic.SumRequest")
-	proto.RegisterType((*SumRequest_Input)(nil), "gnoi.sonic.SumRequest.Input")
-	proto.RegisterType((*SumResponse)(nil), "gnoi.sonic.SumResponse")
-	proto.RegisterType((*SumResponse_Output)(nil), "gnoi.sonic.SumResponse.Output")
-	proto.RegisterType((*CopyConfigRequest)(nil), "gnoi.sonic.CopyConfigRequest")
-	proto.RegisterType((*CopyConfigRequest_Input)(nil), "gnoi.sonic.CopyConfigRequest.Input")
-	proto.RegisterType((*CopyConfigResponse)(nil), "gnoi.sonic.CopyConfigResponse")
-	proto.RegisterType((*ImageInstallRequest)(nil), "gnoi.sonic.ImageInstallRequest")
-	proto.RegisterType((*ImageInstallRequest_Input)(nil), "gnoi.sonic.ImageInstallRequest.Input")
-	proto.RegisterType((*ImageInstallResponse)(nil), "gnoi.sonic.ImageInstallResponse")
-	proto.RegisterType((*ImageRemoveRequest)(nil), "gnoi.sonic.ImageRemoveRequest")
-	proto.RegisterType((*ImageRemoveRequest_Input)(nil), "gnoi.sonic.ImageRemoveRequest.Input")
-	proto.RegisterType((*ImageRemoveResponse)(nil), "gnoi.sonic.ImageRemoveResponse")
-	proto.RegisterType((*ImageDefaultRequest)(nil), "gnoi.sonic.ImageDefaultRequest")
-	proto.RegisterType((*ImageDefaultRequest_Input)(nil), "gnoi.sonic.ImageDefaultRequest.Input")
-	proto.RegisterType((*ImageDefaultResponse)(nil), "gnoi.sonic.ImageDefaultResponse")
-	proto.RegisterType((*JwtToken)(nil), "gnoi.sonic.JwtToken")
-	proto.RegisterType((*AuthenticateRequest)(nil), "gnoi.sonic.AuthenticateRequest")
-	proto.RegisterType((*AuthenticateResponse)(nil), "gnoi.sonic.AuthenticateResponse")
-	proto.RegisterType((*RefreshRequest)(nil), "gnoi.sonic.RefreshRequest")
-	proto.RegisterType((*RefreshResponse)(nil), "gnoi.sonic.RefreshResponse")
+// Counters of paths in COUNTERS_DB, ex. "COUNTERS/Ethernet*", are saved as
+// a named baseline of the calling user. gNMI paths with key "baseline" of
+// that name in any element get counters relative to the baseline.
+type SaveCounterBaselineRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Namespace of paths, "*" for all namespaces
+	Namespace string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Paths     []string `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
 }
 
-func init() { proto.RegisterFile("sonic.proto", fileDescriptor_2d8b4eb81a68e9be) }
-
-var fileDescriptor_2d8b4eb81a68e9be = []byte{
-	// 1151 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xb6, 0x71, 0xbe, 0xf1, 0x73, 0xbe, 0x09, 0x9d, 0xa4, 0x49, 0xb4, 0x4d, 0x76, 0xd3,
-	0x6d, 0x43, 0x43, 0xc1, 0xae, 0x48, 0x39, 0xa0, 0x4a, 0x1c, 0x70, 0x22, 0x44, 0x90, 0x5a, 0xd0,
-	0x3a, 0x07, 0x10, 0x42, 0xee, 0xc6, 0x19, 0xdb, 0x23, 0xbc, 0x3b, 0xcb, 0xce, 0x6c, 0xd2, 0x08,
-	0x0e, 0xfc, 0x09, 0x70, 0xe7, 0xc2, 0x0d, 0x09, 0xc1, 0xdf, 0xc1, 0x31, 0xc7, 0x9e, 0x16, 0xe2,
-	0x70, 0x40, 0x3e, 0x55, 0xfc, 0x05, 0xc8, 0x33, 0xb3, 0xeb, 0x5d, 0xff, 0xc8, 0x86, 0x03, 0xb9,
-	0xed, 0x9b, 0xf7, 0x99, 0xf7, 0x3e, 0xf3, 0x79, 0x6f, 0x9e, 0xc7, 0x50, 0x66, 0xd4, 0x23, 0xcd,
-	0xaa, 0x1f, 0x50, 0x4e, 0x11, 0xb4, 0x3d, 0x4a, 0xaa, 0x62, 0x45, 0xaf, 0xb4, 0x09, 0xef, 0x84,
-	0x87, 0xd5, 0x26, 0x75, 0x1f, 0xb5, 0x69, 0x9b, 0x3e, 0x12, 0x90, 0xc3, 0xb0, 0x25, 0x2c, 0x61,
-	0x88, 0x2f, 0xb9, 0xd5, 0xa2, 0x50, 0xae, 0x0f, 0xf6, 0x7d, 0x1c, 0x72, 0x3f, 0xe4, 0x68, 0x05,
-	0x66, 0x19, 0x77, 0x78, 0xc8, 0xd6, 0xb4, 0x4d, 0x6d, 0xbb, 0x68, 0x2b, 0x0b, 0x7d, 0x08, 0xff,
-	0x97, 0x5f, 0x8d, 0x23, 0xcc, 0x1d, 0xd2, 0x5d, 0xbb, 0xb1, 0xa9, 0x6d, 0x97, 0x6a, 0xf7, 0xfa,
-	0x91, 0xa9, 0x1c, 0x15, 0xe9, 0xf8, 0x3b, 0x32, 0x17, 0x5e, 0xb8, 0xdd, 0x27, 0xd6, 0x5b, 0x4d,
-	0xea, 0xba, 0xd8, 0xe3, 0x96, 0x3d, 0x2f, 0x01, 0x7b, 0xc2, 0x6f, 0xfd, 0xa8, 0x01, 0x3a, 0xc0,
-	0xcd, 0x0e, 0x0b, 0x7d, 0x9f, 0x06, 0xdc, 0xc6, 0x5f, 0x85, 0x98, 0x71, 0xe4, 0x41, 0x91, 0x78,
-	0x7e, 0xc8, 0x45, 0xde, 0xf2, 0xce, 0xfd, 0xea, 0xf0, 0x48, 0xd5, 0x71, 0x78, 0x75, 0x7f, 0x80,
-	0xad, 0xed, 0xf4, 0x23, 0x73, 0x5d, 0x60, 0x2a, 0xac, 0x43, 0x4f, 0x2a, 0x7c, 0x08, 0x7c, 0x22,
-	0xa2, 0x4d, 0x60, 0x23, 0xd3, 0xe8, 0x77, 0xa0, 0x28, 0x62, 0x20, 0x04, 0x33, 0x47, 0x0e, 0xc7,
-	0x22, 0x6f, 0xc9, 0x16, 0xdf, 0xd6, 0x9f, 0x1a, 0x2c, 0x65, 0x92, 0x32, 0x9f, 0x7a, 0x0c, 0x23,
-	0x06, 0xb3, 0x54, 0xe8, 0xa4, 0x58, 0xbe, 0x3e, 0x95, 0xa5, 0xdc, 0x50, 0x95, 0xaa, 0xd6, 0x1e,
-	0xf7, 0x23, 0x73, 0x63, 0x0a, 0x4f, 0x19, 0x70, 0x02, 0x51, 0x95, 0x4a, 0xff, 0x14, 0x66, 0x55,
-	0x71, 0x9e, 0xc1, 0xa2, 0x5c, 0x6b, 0xb4, 0x48, 0x17, 0x7b, 0x8e, 0xab, 0x58, 0xd7, 0xb6, 0xfa,
-	0x91, 0xa9, 0x5c, 0x95, 0xd8, 0x35, 0x21, 0xe2, 0x82, 0x84, 0x7c, 0xa0, 0x10, 0xd6, 0xb9, 0x06,
-	0xb7, 0x77, 0xbb, 0xd8, 0x09, 0x9e, 0x61, 0xd2, 0xee, 0x1c, 0xd2, 0x80, 0xc5, 0xd5, 0x20, 0xd9,
-	0x6a, 0x3c, 0x48, 0x9f, 0x73, 0xe2, 0x0e, 0x55, 0x90, 0x87, 0xfd, 0xc8, 0x5c, 0x96, 0x07, 0xf5,
-	0x14, 0x22, 0xaf, 0x10, 0x5f, 0xc4, 0x85, 0x58, 0x86, 0x62, 0x8b, 0x06, 0x4d, 0x79, 0xa6, 0x39,
-	0x5b, 0x1a, 0x83, 0x86, 0x6c, 0x39, 0x2e, 0xe9, 0x9e, 0xca, 0x8e, 0xb3, 0x95, 0x85, 0x16, 0xe0,
-	0x06, 0xf1, 0xd7, 0x6e, 0x8a, 0xb5, 0x1b, 0xc4, 0x1f, 0xe0, 0x48, 0x4b, 0x48, 0x32, 0x23, 0x71,
-	0xd2, 0xb2, 0x7e, 0xd5, 0x60, 0x65, 0x94, 0xb1, 0xaa, 0xa6, 0x37, 0x52, 0xcd, 0x37, 0x2e, 0x3b,
-	0x65, 0xb6, 0xa0, 0x6f, 0xf6, 0x23, 0xf3, 0xf6, 0xc8, 0x39, 0x73, 0x0b, 0x79, 0x3f, 0x29, 0xa4,
-	0x0e, 0x73, 0x81, 0x8a, 0xa8, 0xfa, 0x2e, 0xb1, 0xad, 0x1f, 0x34, 0x80, 0x7a, 0xe8, 0xc6, 0x95,
-	0xf8, 0x3c, 0x5b, 0x89, 0xf5, 0x34, 0xc7, 0x21, 0x4c, 0xc9, 0xff, 0xa0, 0x1f, 0x99, 0xb7, 0x24,
-	0x2d, 0x8e, 0x19, 0x67, 0x79, 0xda, 0xbf, 0x9d, 0xba, 0x04, 0x5d, 0xdc, 0xe2, 0xea, 0xd2, 0x8b,
-	0xef, 0x41, 0x3d, 0x02, 0xd2, 0xee, 0x70, 0x21, 0x7c, 0xd1, 0x96, 0x86, 0xf5, 0xbd, 0x06, 0x65,
-	0x91, 0x57, 0x89, 0xf8, 0x7c, 0x44, 0x44, 0x63, 0x8c, 0x60, 0x56, 0xb9, 0xed, 0x7e, 0x64, 0xa2,
-	0x34, 0xc5, 0x5c, 0xd9, 0x36, 0x13, 0xd9, 0x56, 0x60, 0x36, 0xc0, 0x2c, 0xec, 0xc6, 0x3c, 0x95,
-	0x65, 0x45, 0x1a, 0xdc, 0xda, 0xa5, 0xfe, 0xe9, 0x2e, 0xf5, 0x5a, 0xa4, 0x1d, 0x2b, 0xd7, 0xc9,
-	0x2a, 0x77, 0x2f, 0x53, 0xdd, 0x51, 0xb4, 0x12, 0xb0, 0xd2, 0x8f, 0xcc, 0x55, 0xc9, 0xae, 0x29,
-	0xdc, 0x15, 0xb7, 0xed, 0xe6, 0xce, 0x92, 0x46, 0x2c, 0xe3, 0x60, 0x7a, 0xd2, 0x30, 0xee, 0xe1,
-	0x92, 0xad, 0x2c, 0xb4, 0x0e, 0x25, 0x7a, 0x8c, 0x83, 0x93, 0x80, 0x70, 0x2c, 0xe4, 0x9c, 0xb3,
-	0x87, 0x0b, 0x68, 0x13, 0xca, 0x47, 0x98, 0x71, 0xe2, 0x39, 0x9c, 0x50, 0x4f, 0xf5, 0x74, 0x7a,
-	0xc9, 0x0a, 0x01, 0xa5, 0x19, 0x2b, 0xe9, 0x1b, 0x23, 0xd2, 0xaf, 0x66, 0xa4, 0x1f, 0x0e, 0xf5,
-	0x5a, 0xb5, 0x1f, 0x99, 0x6b, 0xe3, 0xa7, 0xca, 0x53, 0xde, 0xfa, 0x45, 0x83, 0xa5, 0x7d, 0xd7,
-	0x69, 0xe3, 0x7d, 0x8f, 0x71, 0xa7, 0xdb, 0x8d, 0x95, 0xa5, 0x59, 0x65, 0xb7, 0xd2, 0x79, 0x27,
-	0xe0, 0xc7, 0x87, 0x35, 0x19, 0x80, 0x2a, 0xae, 0xe3, 0x39, 0x6d, 0x3c, 0x48, 0x99, 0x27, 0xf0,
-	0x56, 0x2c, 0xf0, 0x3a, 0x94, 0xc4, 0x5e, 0x71, 0xd1, 0xe5, 0x40, 0x18, 0x2e, 0x58, 0xdf, 0xc0,
-	0x72, 0x36, 0xbd, 0x12, 0xea, 0xe8, 0xaa, 0x42, 0xa5, 0xe6, 0xf4, 0x18, 0xc5, 0x5c, 0xb5, 0x7e,
-	0xd6, 0x00, 0x89, 0xf4, 0x36, 0x76, 0xe9, 0x31, 0xbe, 0xca, 0x0f, 0xdb, 0x38, 0xfc, 0xbf, 0xd2,
-	0x4a, 0x1b, 0xd5, 0xea, 0x6b, 0x55, 0xda, 0x38, 0xfb, 0xb5, 0x4a, 0x95, 0x34, 0xd6, 0x1e, 0x6e,
-	0x39, 0x61, 0x97, 0x5f, 0xb9, 0xb1, 0xb2, 0xf8, 0x6b, 0x13, 0x2b, 0x6e, 0xac, 0x24, 0xfd, 0xb5,
-	0xaa, 0xf5, 0x1c, 0xe6, 0x3e, 0x3a, 0xe1, 0x07, 0xf4, 0x4b, 0xec, 0xa1, 0xbb, 0x30, 0xef, 0x34,
-	0x9b, 0x98, 0xb1, 0x06, 0x1f, 0xd8, 0x8a, 0x6a, 0x59, 0xae, 0x49, 0x08, 0x82, 0x19, 0x7e, 0xea,
-	0xc7, 0xd7, 0x43, 0x7c, 0xa3, 0x0d, 0x00, 0xfc, 0xc2, 0x27, 0x01, 0x66, 0x0d, 0x22, 0x27, 0xcc,
-	0x4d, 0xbb, 0xa4, 0x56, 0xf6, 0x3d, 0xeb, 0x29, 0x2c, 0xbd, 0x1f, 0xf2, 0x0e, 0xf6, 0x38, 0x69,
-	0x3a, 0x3c, 0x69, 0x5d, 0x1d, 0xe6, 0x42, 0x86, 0x83, 0x94, 0x26, 0x89, 0x3d, 0xf0, 0xf9, 0x0e,
-	0x63, 0x27, 0x34, 0x38, 0x52, 0x99, 0x12, 0xdb, 0xaa, 0xc1, 0x72, 0x36, 0x9c, 0x92, 0xeb, 0x21,
-	0x14, 0x0f, 0x12, 0xd6, 0xe5, 0x9d, 0xe5, 0xb4, 0x5a, 0xf1, 0x09, 0x6d, 0x09, 0xb1, 0x5e, 0x83,
-	0x05, 0x1b, 0xb7, 0x02, 0xcc, 0x3a, 0x8a, 0x8d, 0xf5, 0x1e, 0x2c, 0x26, 0x2b, 0xff, 0x3e, 0xe0,
-	0xce, 0xef, 0x45, 0x98, 0x17, 0x25, 0xa9, 0xe3, 0xe0, 0x98, 0x34, 0x31, 0x3a, 0x80, 0xc5, 0x7a,
-	0x87, 0x9e, 0xa4, 0x9e, 0x6d, 0xc8, 0xb8, 0xfc, 0xd5, 0xa9, 0x9b, 0x39, 0xef, 0x3d, 0xab, 0x80,
-	0xde, 0x85, 0x9b, 0xf5, 0xd0, 0x45, 0x2b, 0x93, 0x7f, 0xa7, 0xf5, 0xd5, 0x29, 0x3f, 0x8f, 0x56,
-	0x01, 0x3d, 0x05, 0x18, 0x0e, 0x79, 0xb4, 0x71, 0xe9, 0xcf, 0x95, 0x6e, 0x4c, 0x73, 0x27, 0xe1,
-	0xea, 0x30, 0x9f, 0x1e, 0x86, 0xc8, 0xcc, 0x99, 0xd2, 0xfa, 0xe6, 0x74, 0x40, 0x12, 0xf4, 0x13,
-	0x28, 0xa7, 0xa6, 0x46, 0x56, 0xaf, 0xf1, 0x61, 0xa6, 0x9b, 0x53, 0xfd, 0x63, 0x34, 0xd5, 0xd5,
-	0x9a, 0x40, 0x33, 0x7b, 0xe7, 0x27, 0xd0, 0x1c, 0xb9, 0x95, 0x32, 0x68, 0xba, 0x01, 0xb3, 0x41,
-	0x27, 0x74, 0x7a, 0x36, 0xe8, 0xa4, 0xde, 0xb5, 0x0a, 0x68, 0x0f, 0xfe, 0xa7, 0xfa, 0x0f, 0xe9,
-	0x69, 0x78, 0xb6, 0x4d, 0xf5, 0x3b, 0x13, 0x7d, 0x49, 0x94, 0xcf, 0x60, 0x21, 0xfb, 0xb4, 0x44,
-	0x77, 0x73, 0x1f, 0xd7, 0xba, 0x95, 0xff, 0x32, 0xb5, 0x0a, 0xb5, 0x77, 0xce, 0xce, 0x8d, 0xc2,
-	0xcb, 0x73, 0xa3, 0xf0, 0xea, 0xdc, 0xd0, 0xbe, 0xed, 0x19, 0xda, 0x4f, 0x3d, 0x43, 0xfb, 0xad,
-	0x67, 0x68, 0x67, 0x3d, 0x43, 0xfb, 0xa3, 0x67, 0x68, 0x7f, 0xf5, 0x8c, 0xc2, 0xab, 0x9e, 0xa1,
-	0x7d, 0x77, 0x61, 0x14, 0xce, 0x2e, 0x8c, 0xc2, 0xcb, 0x0b, 0xa3, 0x70, 0x38, 0x2b, 0xfe, 0x07,
-	0x3e, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x18, 0x88, 0xd8, 0xe0, 0x51, 0x0e, 0x00, 0x00,
+func (m *SaveCounterBaselineRequest) Reset()      { *m = SaveCounterBaselineRequest{} }
+func (*SaveCounterBaselineRequest) ProtoMessage() {}
+func (*SaveCounterBaselineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{20}
 }
-
-func (this *SonicOutput) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*SonicOutput)
-	if !ok {
-		that2, ok := that.(SonicOutput)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *SaveCounterBaselineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SaveCounterBaselineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SaveCounterBaselineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Status != that1.Status {
-		return false
+}
+func (m *SaveCounterBaselineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveCounterBaselineRequest.Merge(m, src)
+}
+func (m *SaveCounterBaselineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SaveCounterBaselineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveCounterBaselineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveCounterBaselineRequest proto.InternalMessageInfo
+
+func (m *SaveCounterBaselineRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if this.StatusDetail != that1.StatusDetail {
-		return false
+	return ""
+}
+
+func (m *SaveCounterBaselineRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return true
+	return ""
 }
-func (this *TechsupportRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SaveCounterBaselineRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
 	}
+	return nil
+}
 
-	that1, ok := that.(*TechsupportRequest)
-	if !ok {
-		that2, ok := that.(TechsupportRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type SaveCounterBaselineResponse struct {
+	// Number of counters keys saved
+	NumKeys uint32 `protobuf:"varint,1,opt,name=num_keys,json=numKeys,proto3" json:"num_keys,omitempty"`
+}
+
+func (m *SaveCounterBaselineResponse) Reset()      { *m = SaveCounterBaselineResponse{} }
+func (*SaveCounterBaselineResponse) ProtoMessage() {}
+func (*SaveCounterBaselineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{21}
+}
+func (m *SaveCounterBaselineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SaveCounterBaselineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SaveCounterBaselineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Input.Equal(that1.Input) {
-		return false
-	}
-	return true
 }
-func (this *TechsupportRequest_Input) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *SaveCounterBaselineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveCounterBaselineResponse.Merge(m, src)
+}
+func (m *SaveCounterBaselineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SaveCounterBaselineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveCounterBaselineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveCounterBaselineResponse proto.InternalMessageInfo
+
+func (m *SaveCounterBaselineResponse) GetNumKeys() uint32 {
+	if m != nil {
+		return m.NumKeys
 	}
+	return 0
+}
 
-	that1, ok := that.(*TechsupportRequest_Input)
-	if !ok {
-		that2, ok := that.(TechsupportRequest_Input)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DeleteCounterBaselineRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *DeleteCounterBaselineRequest) Reset()      { *m = DeleteCounterBaselineRequest{} }
+func (*DeleteCounterBaselineRequest) ProtoMessage() {}
+func (*DeleteCounterBaselineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{22}
+}
+func (m *DeleteCounterBaselineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteCounterBaselineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteCounterBaselineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Date != that1.Date {
-		return false
-	}
-	return true
 }
-func (this *TechsupportResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DeleteCounterBaselineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCounterBaselineRequest.Merge(m, src)
+}
+func (m *DeleteCounterBaselineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteCounterBaselineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCounterBaselineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCounterBaselineRequest proto.InternalMessageInfo
+
+func (m *DeleteCounterBaselineRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
+	return ""
+}
 
-	that1, ok := that.(*TechsupportResponse)
-	if !ok {
-		that2, ok := that.(TechsupportResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DeleteCounterBaselineResponse struct {
+}
+
+func (m *DeleteCounterBaselineResponse) Reset()      { *m = DeleteCounterBaselineResponse{} }
+func (*DeleteCounterBaselineResponse) ProtoMessage() {}
+func (*DeleteCounterBaselineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{23}
+}
+func (m *DeleteCounterBaselineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteCounterBaselineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteCounterBaselineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Output.Equal(that1.Output) {
-		return false
-	}
-	return true
 }
-func (this *TechsupportResponse_Output) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DeleteCounterBaselineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCounterBaselineResponse.Merge(m, src)
+}
+func (m *DeleteCounterBaselineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteCounterBaselineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCounterBaselineResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*TechsupportResponse_Output)
-	if !ok {
-		that2, ok := that.(TechsupportResponse_Output)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_DeleteCounterBaselineResponse proto.InternalMessageInfo
+
+// A gNMI Set with an EID_EXPERIMENTAL extension of this message is a
+// commit-confirmed commit: the changes of the Set to CONFIG_DB are reverted
+// unless the commit is confirmed by ConfirmCommit within timeout_seconds.
+// The SetResponse has the same extension with the id of
+// the commit.
+type CommitConfirmed struct {
+	TimeoutSeconds uint32 `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// Set in the SetResponse
+	CommitId string `protobuf:"bytes,2,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+}
+
+func (m *CommitConfirmed) Reset()      { *m = CommitConfirmed{} }
+func (*CommitConfirmed) ProtoMessage() {}
+func (*CommitConfirmed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{24}
+}
+func (m *CommitConfirmed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitConfirmed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitConfirmed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.OutputFilename != that1.OutputFilename {
-		return false
+}
+func (m *CommitConfirmed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitConfirmed.Merge(m, src)
+}
+func (m *CommitConfirmed) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitConfirmed) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitConfirmed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitConfirmed proto.InternalMessageInfo
+
+func (m *CommitConfirmed) GetTimeoutSeconds() uint32 {
+	if m != nil {
+		return m.TimeoutSeconds
 	}
-	return true
+	return 0
 }
-func (this *ClearNeighborsRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CommitConfirmed) GetCommitId() string {
+	if m != nil {
+		return m.CommitId
 	}
+	return ""
+}
 
-	that1, ok := that.(*ClearNeighborsRequest)
-	if !ok {
-		that2, ok := that.(ClearNeighborsRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ConfirmCommitRequest struct {
+	CommitId string `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+}
+
+func (m *ConfirmCommitRequest) Reset()      { *m = ConfirmCommitRequest{} }
+func (*ConfirmCommitRequest) ProtoMessage() {}
+func (*ConfirmCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{25}
+}
+func (m *ConfirmCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Input.Equal(that1.Input) {
-		return false
-	}
-	return true
 }
-func (this *ClearNeighborsRequest_Input) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ConfirmCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmCommitRequest.Merge(m, src)
+}
+func (m *ConfirmCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmCommitRequest proto.InternalMessageInfo
+
+func (m *ConfirmCommitRequest) GetCommitId() string {
+	if m != nil {
+		return m.CommitId
 	}
+	return ""
+}
 
-	that1, ok := that.(*ClearNeighborsRequest_Input)
-	if !ok {
-		that2, ok := that.(ClearNeighborsRequest_Input)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ConfirmCommitResponse struct {
+}
+
+func (m *ConfirmCommitResponse) Reset()      { *m = ConfirmCommitResponse{} }
+func (*ConfirmCommitResponse) ProtoMessage() {}
+func (*ConfirmCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{26}
+}
+func (m *ConfirmCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Force != that1.Force {
-		return false
-	}
-	if this.Family != that1.Family {
-		return false
-	}
-	if this.Ip != that1.Ip {
-		return false
-	}
-	if this.Ifname != that1.Ifname {
-		return false
-	}
-	return true
 }
-func (this *ClearNeighborsResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ConfirmCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmCommitResponse.Merge(m, src)
+}
+func (m *ConfirmCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmCommitResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ClearNeighborsResponse)
-	if !ok {
-		that2, ok := that.(ClearNeighborsResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ConfirmCommitResponse proto.InternalMessageInfo
+
+// Rolls back a pending commit at once, reverting its changes only
+type CancelCommitRequest struct {
+	CommitId string `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+}
+
+func (m *CancelCommitRequest) Reset()      { *m = CancelCommitRequest{} }
+func (*CancelCommitRequest) ProtoMessage() {}
+func (*CancelCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{27}
+}
+func (m *CancelCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Output.Equal(that1.Output) {
-		return false
-	}
-	return true
 }
-func (this *ClearNeighborsResponse_Output) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *CancelCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCommitRequest.Merge(m, src)
+}
+func (m *CancelCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelCommitRequest proto.InternalMessageInfo
+
+func (m *CancelCommitRequest) GetCommitId() string {
+	if m != nil {
+		return m.CommitId
 	}
+	return ""
+}
 
-	that1, ok := that.(*ClearNeighborsResponse_Output)
-	if !ok {
-		that2, ok := that.(ClearNeighborsResponse_Output)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CancelCommitResponse struct {
+}
+
+func (m *CancelCommitResponse) Reset()      { *m = CancelCommitResponse{} }
+func (*CancelCommitResponse) ProtoMessage() {}
+func (*CancelCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{28}
+}
+func (m *CancelCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Response != that1.Response {
-		return false
-	}
-	return true
 }
-func (this *SumRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CancelCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCommitResponse.Merge(m, src)
+}
+func (m *CancelCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCommitResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*SumRequest)
-	if !ok {
-		that2, ok := that.(SumRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_CancelCommitResponse proto.InternalMessageInfo
+
+type ListPendingCommitsRequest struct {
+}
+
+func (m *ListPendingCommitsRequest) Reset()      { *m = ListPendingCommitsRequest{} }
+func (*ListPendingCommitsRequest) ProtoMessage() {}
+func (*ListPendingCommitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{29}
+}
+func (m *ListPendingCommitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPendingCommitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPendingCommitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Input.Equal(that1.Input) {
-		return false
-	}
-	return true
 }
-func (this *SumRequest_Input) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ListPendingCommitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPendingCommitsRequest.Merge(m, src)
+}
+func (m *ListPendingCommitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPendingCommitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPendingCommitsRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*SumRequest_Input)
-	if !ok {
-		that2, ok := that.(SumRequest_Input)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ListPendingCommitsRequest proto.InternalMessageInfo
+
+type PendingCommit struct {
+	CommitId string `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	User     string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// Times of the commit and of its rollback, in nanoseconds since epoch
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Deadline  int64 `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *PendingCommit) Reset()      { *m = PendingCommit{} }
+func (*PendingCommit) ProtoMessage() {}
+func (*PendingCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{30}
+}
+func (m *PendingCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *PendingCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCommit.Merge(m, src)
+}
+func (m *PendingCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCommit proto.InternalMessageInfo
+
+func (m *PendingCommit) GetCommitId() string {
+	if m != nil {
+		return m.CommitId
 	}
-	if this.Left != that1.Left {
-		return false
+	return ""
+}
+
+func (m *PendingCommit) GetUser() string {
+	if m != nil {
+		return m.User
 	}
-	if this.Right != that1.Right {
-		return false
+	return ""
+}
+
+func (m *PendingCommit) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return true
+	return 0
 }
-func (this *SumResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PendingCommit) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
+	return 0
+}
 
-	that1, ok := that.(*SumResponse)
-	if !ok {
-		that2, ok := that.(SumResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Output.Equal(that1.Output) {
-		return false
-	}
-	return true
+type ListPendingCommitsResponse struct {
+	Commits []*PendingCommit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
 }
-func (this *SumResponse_Output) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*SumResponse_Output)
-	if !ok {
-		that2, ok := that.(SumResponse_Output)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *ListPendingCommitsResponse) Reset()      { *m = ListPendingCommitsResponse{} }
+func (*ListPendingCommitsResponse) ProtoMessage() {}
+func (*ListPendingCommitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{31}
+}
+func (m *ListPendingCommitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPendingCommitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPendingCommitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Result != that1.Result {
-		return false
-	}
-	return true
 }
-func (this *CopyConfigRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ListPendingCommitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPendingCommitsResponse.Merge(m, src)
+}
+func (m *ListPendingCommitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPendingCommitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPendingCommitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPendingCommitsResponse proto.InternalMessageInfo
+
+func (m *ListPendingCommitsResponse) GetCommits() []*PendingCommit {
+	if m != nil {
+		return m.Commits
 	}
+	return nil
+}
 
-	that1, ok := that.(*CopyConfigRequest)
-	if !ok {
-		that2, ok := that.(CopyConfigRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// CONFIG_DB of a namespace is saved as a named checkpoint, to compare the
+// configuration with, or to roll it back to.
+type CheckpointRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Namespace of CONFIG_DB, "" for the default namespace
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *CheckpointRequest) Reset()      { *m = CheckpointRequest{} }
+func (*CheckpointRequest) ProtoMessage() {}
+func (*CheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{32}
+}
+func (m *CheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Input.Equal(that1.Input) {
-		return false
+}
+func (m *CheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointRequest.Merge(m, src)
+}
+func (m *CheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointRequest proto.InternalMessageInfo
+
+func (m *CheckpointRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return true
+	return ""
 }
-func (this *CopyConfigRequest_Input) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CheckpointRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
+	return ""
+}
 
-	that1, ok := that.(*CopyConfigRequest_Input)
-	if !ok {
-		that2, ok := that.(CopyConfigRequest_Input)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CheckpointResponse struct {
+	// Time of the checkpoint, in nanoseconds since epoch
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Number of entries saved
+	NumEntries uint32 `protobuf:"varint,2,opt,name=num_entries,json=numEntries,proto3" json:"num_entries,omitempty"`
+}
+
+func (m *CheckpointResponse) Reset()      { *m = CheckpointResponse{} }
+func (*CheckpointResponse) ProtoMessage() {}
+func (*CheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{33}
+}
+func (m *CheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Source != that1.Source {
-		return false
-	}
-	if this.Overwrite != that1.Overwrite {
-		return false
-	}
-	if this.Destination != that1.Destination {
-		return false
+}
+func (m *CheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointResponse.Merge(m, src)
+}
+func (m *CheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointResponse proto.InternalMessageInfo
+
+func (m *CheckpointResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return true
+	return 0
 }
-func (this *CopyConfigResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CheckpointResponse) GetNumEntries() uint32 {
+	if m != nil {
+		return m.NumEntries
 	}
+	return 0
+}
 
-	that1, ok := that.(*CopyConfigResponse)
-	if !ok {
-		that2, ok := that.(CopyConfigResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListCheckpointsRequest struct {
+}
+
+func (m *ListCheckpointsRequest) Reset()      { *m = ListCheckpointsRequest{} }
+func (*ListCheckpointsRequest) ProtoMessage() {}
+func (*ListCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{34}
+}
+func (m *ListCheckpointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCheckpointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Output.Equal(that1.Output) {
-		return false
-	}
-	return true
 }
-func (this *ImageInstallRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ListCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCheckpointsRequest.Merge(m, src)
+}
+func (m *ListCheckpointsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCheckpointsRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ImageInstallRequest)
-	if !ok {
-		that2, ok := that.(ImageInstallRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ListCheckpointsRequest proto.InternalMessageInfo
+
+type CheckpointInfo struct {
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace  string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Timestamp  int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	NumEntries uint32 `protobuf:"varint,4,opt,name=num_entries,json=numEntries,proto3" json:"num_entries,omitempty"`
+}
+
+func (m *CheckpointInfo) Reset()      { *m = CheckpointInfo{} }
+func (*CheckpointInfo) ProtoMessage() {}
+func (*CheckpointInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{35}
+}
+func (m *CheckpointInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Input.Equal(that1.Input) {
-		return false
-	}
-	return true
 }
-func (this *ImageInstallRequest_Input) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CheckpointInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointInfo.Merge(m, src)
+}
+func (m *CheckpointInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointInfo.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ImageInstallRequest_Input)
-	if !ok {
-		that2, ok := that.(ImageInstallRequest_Input)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_CheckpointInfo proto.InternalMessageInfo
+
+func (m *CheckpointInfo) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *CheckpointInfo) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if this.Imagename != that1.Imagename {
-		return false
+	return ""
+}
+
+func (m *CheckpointInfo) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return true
+	return 0
 }
-func (this *ImageInstallResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CheckpointInfo) GetNumEntries() uint32 {
+	if m != nil {
+		return m.NumEntries
 	}
+	return 0
+}
 
-	that1, ok := that.(*ImageInstallResponse)
-	if !ok {
-		that2, ok := that.(ImageInstallResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListCheckpointsResponse struct {
+	// By name
+	Checkpoints []*CheckpointInfo `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+}
+
+func (m *ListCheckpointsResponse) Reset()      { *m = ListCheckpointsResponse{} }
+func (*ListCheckpointsResponse) ProtoMessage() {}
+func (*ListCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{36}
+}
+func (m *ListCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCheckpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Output.Equal(that1.Output) {
-		return false
-	}
-	return true
 }
-func (this *ImageRemoveRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ListCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCheckpointsResponse.Merge(m, src)
+}
+func (m *ListCheckpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCheckpointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCheckpointsResponse proto.InternalMessageInfo
+
+func (m *ListCheckpointsResponse) GetCheckpoints() []*CheckpointInfo {
+	if m != nil {
+		return m.Checkpoints
 	}
+	return nil
+}
 
-	that1, ok := that.(*ImageRemoveRequest)
-	if !ok {
-		that2, ok := that.(ImageRemoveRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DiffCheckpointRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *DiffCheckpointRequest) Reset()      { *m = DiffCheckpointRequest{} }
+func (*DiffCheckpointRequest) ProtoMessage() {}
+func (*DiffCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{37}
+}
+func (m *DiffCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiffCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiffCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Input.Equal(that1.Input) {
-		return false
-	}
-	return true
 }
-func (this *ImageRemoveRequest_Input) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DiffCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffCheckpointRequest.Merge(m, src)
+}
+func (m *DiffCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiffCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffCheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffCheckpointRequest proto.InternalMessageInfo
+
+func (m *DiffCheckpointRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
+	return ""
+}
 
-	that1, ok := that.(*ImageRemoveRequest_Input)
-	if !ok {
-		that2, ok := that.(ImageRemoveRequest_Input)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// An entry of CONFIG_DB differing from a checkpoint
+type CheckpointDiff struct {
+	Table  string                  `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Key    string                  `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Change CheckpointDiff_Change   `protobuf:"varint,3,opt,name=change,proto3,enum=gnoi.sonic.CheckpointDiff_Change" json:"change,omitempty"`
+	Fields []*CheckpointDiff_Field `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (m *CheckpointDiff) Reset()      { *m = CheckpointDiff{} }
+func (*CheckpointDiff) ProtoMessage() {}
+func (*CheckpointDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{38}
+}
+func (m *CheckpointDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Imagename != that1.Imagename {
-		return false
-	}
-	return true
 }
-func (this *ImageRemoveResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CheckpointDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointDiff.Merge(m, src)
+}
+func (m *CheckpointDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointDiff.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ImageRemoveResponse)
-	if !ok {
-		that2, ok := that.(ImageRemoveResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_CheckpointDiff proto.InternalMessageInfo
+
+func (m *CheckpointDiff) GetTable() string {
+	if m != nil {
+		return m.Table
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *CheckpointDiff) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if !this.Output.Equal(that1.Output) {
-		return false
+	return ""
+}
+
+func (m *CheckpointDiff) GetChange() CheckpointDiff_Change {
+	if m != nil {
+		return m.Change
 	}
-	return true
+	return CheckpointDiff_MODIFIED
 }
-func (this *ImageDefaultRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CheckpointDiff) GetFields() []*CheckpointDiff_Field {
+	if m != nil {
+		return m.Fields
 	}
+	return nil
+}
 
-	that1, ok := that.(*ImageDefaultRequest)
-	if !ok {
-		that2, ok := that.(ImageDefaultRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CheckpointDiff_Field struct {
+	Field  string                `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Change CheckpointDiff_Change `protobuf:"varint,2,opt,name=change,proto3,enum=gnoi.sonic.CheckpointDiff_Change" json:"change,omitempty"`
+	// Values in the checkpoint and in CONFIG_DB, "" when absent
+	CheckpointValue string `protobuf:"bytes,3,opt,name=checkpoint_value,json=checkpointValue,proto3" json:"checkpoint_value,omitempty"`
+	CurrentValue    string `protobuf:"bytes,4,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+}
+
+func (m *CheckpointDiff_Field) Reset()      { *m = CheckpointDiff_Field{} }
+func (*CheckpointDiff_Field) ProtoMessage() {}
+func (*CheckpointDiff_Field) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{38, 0}
+}
+func (m *CheckpointDiff_Field) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointDiff_Field) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointDiff_Field.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Input.Equal(that1.Input) {
-		return false
-	}
-	return true
 }
-func (this *ImageDefaultRequest_Input) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CheckpointDiff_Field) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointDiff_Field.Merge(m, src)
+}
+func (m *CheckpointDiff_Field) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointDiff_Field) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointDiff_Field.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ImageDefaultRequest_Input)
-	if !ok {
-		that2, ok := that.(ImageDefaultRequest_Input)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_CheckpointDiff_Field proto.InternalMessageInfo
+
+func (m *CheckpointDiff_Field) GetField() string {
+	if m != nil {
+		return m.Field
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *CheckpointDiff_Field) GetChange() CheckpointDiff_Change {
+	if m != nil {
+		return m.Change
 	}
-	if this.Imagename != that1.Imagename {
-		return false
+	return CheckpointDiff_MODIFIED
+}
+
+func (m *CheckpointDiff_Field) GetCheckpointValue() string {
+	if m != nil {
+		return m.CheckpointValue
 	}
-	return true
+	return ""
 }
-func (this *ImageDefaultResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CheckpointDiff_Field) GetCurrentValue() string {
+	if m != nil {
+		return m.CurrentValue
 	}
+	return ""
+}
 
-	that1, ok := that.(*ImageDefaultResponse)
-	if !ok {
-		that2, ok := that.(ImageDefaultResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DiffCheckpointResponse struct {
+	// By table then key
+	Entries []*CheckpointDiff `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *DiffCheckpointResponse) Reset()      { *m = DiffCheckpointResponse{} }
+func (*DiffCheckpointResponse) ProtoMessage() {}
+func (*DiffCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{39}
+}
+func (m *DiffCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiffCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiffCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Output.Equal(that1.Output) {
-		return false
-	}
-	return true
 }
-func (this *JwtToken) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DiffCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffCheckpointResponse.Merge(m, src)
+}
+func (m *DiffCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiffCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffCheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffCheckpointResponse proto.InternalMessageInfo
+
+func (m *DiffCheckpointResponse) GetEntries() []*CheckpointDiff {
+	if m != nil {
+		return m.Entries
 	}
+	return nil
+}
 
-	that1, ok := that.(*JwtToken)
-	if !ok {
-		that2, ok := that.(JwtToken)
+// Restores CONFIG_DB to a checkpoint, rewriting the entries changed since
+// in one transaction
+type RollbackRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *RollbackRequest) Reset()      { *m = RollbackRequest{} }
+func (*RollbackRequest) ProtoMessage() {}
+func (*RollbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{40}
+}
+func (m *RollbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackRequest.Merge(m, src)
+}
+func (m *RollbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackRequest proto.InternalMessageInfo
+
+func (m *RollbackRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RollbackResponse struct {
+	// Number of entries rewritten
+	NumEntries uint32 `protobuf:"varint,1,opt,name=num_entries,json=numEntries,proto3" json:"num_entries,omitempty"`
+}
+
+func (m *RollbackResponse) Reset()      { *m = RollbackResponse{} }
+func (*RollbackResponse) ProtoMessage() {}
+func (*RollbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{41}
+}
+func (m *RollbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackResponse.Merge(m, src)
+}
+func (m *RollbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackResponse proto.InternalMessageInfo
+
+func (m *RollbackResponse) GetNumEntries() uint32 {
+	if m != nil {
+		return m.NumEntries
+	}
+	return 0
+}
+
+// Calls any YANG RPC registered in translib, by its path, ex.
+// "/sonic-tests:sum", with its input and output as RFC7951 JSON, ex.
+// {"sonic-tests:input": {"left": 1, "right": 2}}
+type ActionRequest struct {
+	Path      string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	JsonInput string `protobuf:"bytes,2,opt,name=json_input,json=jsonInput,proto3" json:"json_input,omitempty"`
+}
+
+func (m *ActionRequest) Reset()      { *m = ActionRequest{} }
+func (*ActionRequest) ProtoMessage() {}
+func (*ActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{42}
+}
+func (m *ActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionRequest.Merge(m, src)
+}
+func (m *ActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionRequest proto.InternalMessageInfo
+
+func (m *ActionRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ActionRequest) GetJsonInput() string {
+	if m != nil {
+		return m.JsonInput
+	}
+	return ""
+}
+
+type ActionResponse struct {
+	JsonOutput string `protobuf:"bytes,1,opt,name=json_output,json=jsonOutput,proto3" json:"json_output,omitempty"`
+}
+
+func (m *ActionResponse) Reset()      { *m = ActionResponse{} }
+func (*ActionResponse) ProtoMessage() {}
+func (*ActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8b4eb81a68e9be, []int{43}
+}
+func (m *ActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionResponse.Merge(m, src)
+}
+func (m *ActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionResponse proto.InternalMessageInfo
+
+func (m *ActionResponse) GetJsonOutput() string {
+	if m != nil {
+		return m.JsonOutput
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("gnoi.sonic.CheckpointDiff_Change", CheckpointDiff_Change_name, CheckpointDiff_Change_value)
+	proto.RegisterType((*SonicOutput)(nil), "gnoi.sonic.SonicOutput")
+	proto.RegisterType((*TechsupportRequest)(nil), "gnoi.sonic.TechsupportRequest")
+	proto.RegisterType((*TechsupportRequest_Input)(nil), "gnoi.sonic.TechsupportRequest.Input")
+	proto.RegisterType((*TechsupportResponse)(nil), "gnoi.sonic.TechsupportResponse")
+	proto.RegisterType((*TechsupportResponse_Output)(nil), "gnoi.sonic.TechsupportResponse.Output")
+	proto.RegisterType((*ClearNeighborsRequest)(nil), "gnoi.sonic.ClearNeighborsRequest")
+	proto.RegisterType((*ClearNeighborsRequest_Input)(nil), "gnoi.sonic.ClearNeighborsRequest.Input")
+	proto.RegisterType((*ClearNeighborsResponse)(nil), "gnoi.sonic.ClearNeighborsResponse")
+	proto.RegisterType((*ClearNeighborsResponse_Output)(nil), "gnoi.sonic.ClearNeighborsResponse.Output")
+	proto.RegisterType((*SumRequest)(nil), "gnoi.sonic.SumRequest")
+	proto.RegisterType((*SumRequest_Input)(nil), "gnoi.sonic.SumRequest.Input")
+	proto.RegisterType((*SumResponse)(nil), "gnoi.sonic.SumResponse")
+	proto.RegisterType((*SumResponse_Output)(nil), "gnoi.sonic.SumResponse.Output")
+	proto.RegisterType((*CopyConfigRequest)(nil), "gnoi.sonic.CopyConfigRequest")
+	proto.RegisterType((*CopyConfigRequest_Input)(nil), "gnoi.sonic.CopyConfigRequest.Input")
+	proto.RegisterType((*CopyConfigResponse)(nil), "gnoi.sonic.CopyConfigResponse")
+	proto.RegisterType((*ImageInstallRequest)(nil), "gnoi.sonic.ImageInstallRequest")
+	proto.RegisterType((*ImageInstallRequest_Input)(nil), "gnoi.sonic.ImageInstallRequest.Input")
+	proto.RegisterType((*ImageInstallResponse)(nil), "gnoi.sonic.ImageInstallResponse")
+	proto.RegisterType((*ImageRemoveRequest)(nil), "gnoi.sonic.ImageRemoveRequest")
+	proto.RegisterType((*ImageRemoveRequest_Input)(nil), "gnoi.sonic.ImageRemoveRequest.Input")
+	proto.RegisterType((*ImageRemoveResponse)(nil), "gnoi.sonic.ImageRemoveResponse")
+	proto.RegisterType((*ImageDefaultRequest)(nil), "gnoi.sonic.ImageDefaultRequest")
+	proto.RegisterType((*ImageDefaultRequest_Input)(nil), "gnoi.sonic.ImageDefaultRequest.Input")
+	proto.RegisterType((*ImageDefaultResponse)(nil), "gnoi.sonic.ImageDefaultResponse")
+	proto.RegisterType((*JwtToken)(nil), "gnoi.sonic.JwtToken")
+	proto.RegisterType((*AuthenticateRequest)(nil), "gnoi.sonic.AuthenticateRequest")
+	proto.RegisterType((*AuthenticateResponse)(nil), "gnoi.sonic.AuthenticateResponse")
+	proto.RegisterType((*RefreshRequest)(nil), "gnoi.sonic.RefreshRequest")
+	proto.RegisterType((*RefreshResponse)(nil), "gnoi.sonic.RefreshResponse")
+	proto.RegisterType((*SaveCounterBaselineRequest)(nil), "gnoi.sonic.SaveCounterBaselineRequest")
+	proto.RegisterType((*SaveCounterBaselineResponse)(nil), "gnoi.sonic.SaveCounterBaselineResponse")
+	proto.RegisterType((*DeleteCounterBaselineRequest)(nil), "gnoi.sonic.DeleteCounterBaselineRequest")
+	proto.RegisterType((*DeleteCounterBaselineResponse)(nil), "gnoi.sonic.DeleteCounterBaselineResponse")
+	proto.RegisterType((*CommitConfirmed)(nil), "gnoi.sonic.CommitConfirmed")
+	proto.RegisterType((*ConfirmCommitRequest)(nil), "gnoi.sonic.ConfirmCommitRequest")
+	proto.RegisterType((*ConfirmCommitResponse)(nil), "gnoi.sonic.ConfirmCommitResponse")
+	proto.RegisterType((*CancelCommitRequest)(nil), "gnoi.sonic.CancelCommitRequest")
+	proto.RegisterType((*CancelCommitResponse)(nil), "gnoi.sonic.CancelCommitResponse")
+	proto.RegisterType((*ListPendingCommitsRequest)(nil), "gnoi.sonic.ListPendingCommitsRequest")
+	proto.RegisterType((*PendingCommit)(nil), "gnoi.sonic.PendingCommit")
+	proto.RegisterType((*ListPendingCommitsResponse)(nil), "gnoi.sonic.ListPendingCommitsResponse")
+	proto.RegisterType((*CheckpointRequest)(nil), "gnoi.sonic.CheckpointRequest")
+	proto.RegisterType((*CheckpointResponse)(nil), "gnoi.sonic.CheckpointResponse")
+	proto.RegisterType((*ListCheckpointsRequest)(nil), "gnoi.sonic.ListCheckpointsRequest")
+	proto.RegisterType((*CheckpointInfo)(nil), "gnoi.sonic.CheckpointInfo")
+	proto.RegisterType((*ListCheckpointsResponse)(nil), "gnoi.sonic.ListCheckpointsResponse")
+	proto.RegisterType((*DiffCheckpointRequest)(nil), "gnoi.sonic.DiffCheckpointRequest")
+	proto.RegisterType((*CheckpointDiff)(nil), "gnoi.sonic.CheckpointDiff")
+	proto.RegisterType((*CheckpointDiff_Field)(nil), "gnoi.sonic.CheckpointDiff.Field")
+	proto.RegisterType((*DiffCheckpointResponse)(nil), "gnoi.sonic.DiffCheckpointResponse")
+	proto.RegisterType((*RollbackRequest)(nil), "gnoi.sonic.RollbackRequest")
+	proto.RegisterType((*RollbackResponse)(nil), "gnoi.sonic.RollbackResponse")
+	proto.RegisterType((*ActionRequest)(nil), "gnoi.sonic.ActionRequest")
+	proto.RegisterType((*ActionResponse)(nil), "gnoi.sonic.ActionResponse")
+}
+
+func init() { proto.RegisterFile("sonic.proto", fileDescriptor_2d8b4eb81a68e9be) }
+
+var fileDescriptor_2d8b4eb81a68e9be = []byte{
+	// 1946 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x73, 0x1b, 0x49,
+	0x15, 0xd7, 0xc4, 0x9f, 0x7a, 0xf2, 0x57, 0xda, 0x9f, 0x19, 0x3b, 0x92, 0xd2, 0xd9, 0x6c, 0x9c,
+	0x5d, 0xec, 0xad, 0xb5, 0x73, 0x08, 0x5b, 0x70, 0x88, 0x2d, 0x07, 0x0c, 0x24, 0x59, 0x46, 0xa9,
+	0x2c, 0x14, 0x50, 0xda, 0xf1, 0xa8, 0x25, 0x0d, 0x9e, 0x0f, 0x31, 0xd3, 0x63, 0xaf, 0x81, 0x03,
+	0xdc, 0x38, 0x51, 0x70, 0xe7, 0x42, 0x71, 0xd9, 0x2a, 0x0a, 0xfe, 0x0e, 0x8e, 0x39, 0xee, 0x49,
+	0x85, 0x1d, 0x0e, 0x94, 0x4e, 0x5b, 0xfc, 0x05, 0x54, 0x7f, 0xcc, 0x68, 0x5a, 0x1a, 0x49, 0x4e,
+	0xaa, 0x36, 0x27, 0x75, 0xbf, 0xfe, 0xf5, 0x7b, 0xaf, 0x7f, 0xef, 0x75, 0xf7, 0xeb, 0x11, 0x14,
+	0x42, 0xdf, 0xb3, 0xad, 0xdd, 0x76, 0xe0, 0x53, 0x1f, 0x41, 0xd3, 0xf3, 0xed, 0x5d, 0x2e, 0xd1,
+	0x77, 0x9a, 0x36, 0x6d, 0x45, 0x27, 0xbb, 0x96, 0xef, 0x7e, 0xd4, 0xf4, 0x9b, 0xfe, 0x47, 0x1c,
+	0x72, 0x12, 0x35, 0x78, 0x8f, 0x77, 0x78, 0x4b, 0x4c, 0xc5, 0x3e, 0x14, 0xaa, 0x6c, 0xde, 0xf3,
+	0x88, 0xb6, 0x23, 0x8a, 0xd6, 0x60, 0x3a, 0xa4, 0x26, 0x8d, 0xc2, 0x0d, 0xad, 0xac, 0x6d, 0x4f,
+	0x19, 0xb2, 0x87, 0xbe, 0x0f, 0xf3, 0xa2, 0x55, 0xab, 0x13, 0x6a, 0xda, 0xce, 0xc6, 0x8d, 0xb2,
+	0xb6, 0x9d, 0x3f, 0xb8, 0xdb, 0xed, 0x94, 0xe4, 0xc0, 0x8e, 0x18, 0xf8, 0x5f, 0xa7, 0xb4, 0xf0,
+	0x85, 0xeb, 0x7c, 0x82, 0xbf, 0x65, 0xf9, 0xae, 0x4b, 0x3c, 0x8a, 0x8d, 0x39, 0x01, 0xa8, 0xf0,
+	0x71, 0xfc, 0x57, 0x0d, 0xd0, 0x0b, 0x62, 0xb5, 0xc2, 0xa8, 0xdd, 0xf6, 0x03, 0x6a, 0x90, 0x5f,
+	0x45, 0x24, 0xa4, 0xc8, 0x83, 0x29, 0xdb, 0x6b, 0x47, 0x94, 0xdb, 0x2d, 0xec, 0xbd, 0xb7, 0xdb,
+	0x5b, 0xd2, 0xee, 0x20, 0x7c, 0xf7, 0x98, 0x61, 0x0f, 0xf6, 0xba, 0x9d, 0xd2, 0x16, 0xc7, 0xec,
+	0x84, 0x2d, 0xff, 0x7c, 0x87, 0xf6, 0x80, 0x9f, 0x70, 0x6d, 0x19, 0xde, 0x08, 0x33, 0xfa, 0x26,
+	0x4c, 0x71, 0x1d, 0x08, 0xc1, 0x64, 0xdd, 0xa4, 0x84, 0xdb, 0xcd, 0x1b, 0xbc, 0x8d, 0xff, 0xa3,
+	0xc1, 0xb2, 0x62, 0x34, 0x6c, 0xfb, 0x5e, 0x48, 0x50, 0x08, 0xd3, 0x3e, 0xe7, 0x49, 0x7a, 0xf9,
+	0xfe, 0x50, 0x2f, 0xc5, 0x84, 0x5d, 0xc1, 0xea, 0xc1, 0x7e, 0xb7, 0x53, 0xba, 0x3d, 0xc4, 0x4f,
+	0xa1, 0x30, 0xc3, 0x51, 0x69, 0x4a, 0xff, 0x09, 0x4c, 0xcb, 0xe0, 0x3c, 0x83, 0x45, 0x21, 0xab,
+	0x35, 0x6c, 0x87, 0x78, 0xa6, 0x2b, 0xbd, 0x3e, 0xb8, 0xd7, 0xed, 0x94, 0xe4, 0xd0, 0x4e, 0x3c,
+	0x94, 0xa1, 0x71, 0x41, 0x40, 0x9e, 0x48, 0x04, 0xbe, 0xd4, 0x60, 0xf5, 0xd0, 0x21, 0x66, 0xf0,
+	0x8c, 0xd8, 0xcd, 0xd6, 0x89, 0x1f, 0x84, 0x71, 0x34, 0x6c, 0x35, 0x1a, 0xf7, 0xd3, 0xeb, 0xcc,
+	0x9c, 0x21, 0x03, 0xf2, 0x41, 0xb7, 0x53, 0x5a, 0x11, 0x0b, 0xf5, 0x24, 0x62, 0x5c, 0x20, 0x7e,
+	0x11, 0x07, 0x62, 0x05, 0xa6, 0x1a, 0x7e, 0x60, 0x89, 0x35, 0xcd, 0x1a, 0xa2, 0xc3, 0x12, 0xb2,
+	0x61, 0xba, 0xb6, 0x73, 0x21, 0x32, 0xce, 0x90, 0x3d, 0xb4, 0x00, 0x37, 0xec, 0xf6, 0xc6, 0x04,
+	0x97, 0xdd, 0xb0, 0xdb, 0x0c, 0x67, 0x37, 0x38, 0x25, 0x93, 0x02, 0x27, 0x7a, 0xf8, 0x9f, 0x1a,
+	0xac, 0xf5, 0x7b, 0x2c, 0xa3, 0xe9, 0xf5, 0x45, 0xf3, 0xc1, 0xa8, 0x55, 0xaa, 0x01, 0xfd, 0xb0,
+	0xdb, 0x29, 0xad, 0xf6, 0xad, 0x73, 0x6c, 0x20, 0xdf, 0x4b, 0x02, 0xa9, 0xc3, 0x6c, 0x20, 0x35,
+	0xca, 0xbc, 0x4b, 0xfa, 0xf8, 0x2f, 0x1a, 0x40, 0x35, 0x72, 0xe3, 0x48, 0xfc, 0x4c, 0x8d, 0xc4,
+	0x56, 0xda, 0xc7, 0x1e, 0x4c, 0xd2, 0x7f, 0xbf, 0xdb, 0x29, 0xdd, 0x14, 0x6e, 0x51, 0x12, 0xd2,
+	0x70, 0x1c, 0xf7, 0x1f, 0xa7, 0x36, 0x81, 0x43, 0x1a, 0x54, 0x6e, 0x7a, 0xde, 0x66, 0xf1, 0x08,
+	0xec, 0x66, 0x8b, 0x72, 0xe2, 0xa7, 0x0c, 0xd1, 0xc1, 0x7f, 0xd6, 0xa0, 0xc0, 0xed, 0x4a, 0x12,
+	0x3f, 0xef, 0x23, 0xb1, 0x38, 0xe0, 0xa0, 0xca, 0xdc, 0x76, 0xb7, 0x53, 0x42, 0x69, 0x17, 0xc7,
+	0xd2, 0x56, 0x4e, 0x68, 0x5b, 0x83, 0xe9, 0x80, 0x84, 0x91, 0x13, 0xfb, 0x29, 0x7b, 0xb8, 0xa3,
+	0xc1, 0xcd, 0x43, 0xbf, 0x7d, 0x71, 0xe8, 0x7b, 0x0d, 0xbb, 0x19, 0x33, 0xd7, 0x52, 0x99, 0xbb,
+	0xab, 0x44, 0xb7, 0x1f, 0x2d, 0x09, 0xdc, 0xe9, 0x76, 0x4a, 0xeb, 0xc2, 0x3b, 0x8b, 0x0f, 0xef,
+	0xb8, 0x4d, 0x77, 0xec, 0x59, 0x52, 0x8b, 0x69, 0x64, 0xa7, 0xa7, 0x1f, 0xc5, 0x39, 0x9c, 0x37,
+	0x64, 0x0f, 0x6d, 0x41, 0xde, 0x3f, 0x23, 0xc1, 0x79, 0x60, 0x53, 0xc2, 0xe9, 0x9c, 0x35, 0x7a,
+	0x02, 0x54, 0x86, 0x42, 0x9d, 0x84, 0xd4, 0xf6, 0x4c, 0x6a, 0xfb, 0x9e, 0xcc, 0xe9, 0xb4, 0x08,
+	0x47, 0x80, 0xd2, 0x1e, 0x4b, 0xea, 0x6b, 0x7d, 0xd4, 0xaf, 0x2b, 0xd4, 0xf7, 0x0e, 0xf5, 0x83,
+	0xdd, 0x6e, 0xa7, 0xb4, 0x31, 0xb8, 0xaa, 0x71, 0xcc, 0xe3, 0x7f, 0x68, 0xb0, 0x7c, 0xec, 0x9a,
+	0x4d, 0x72, 0xec, 0x85, 0xd4, 0x74, 0x9c, 0x98, 0x59, 0x5f, 0x65, 0xf6, 0x5e, 0xda, 0x6e, 0x06,
+	0x7e, 0xf0, 0xb0, 0xb6, 0x19, 0x68, 0xc7, 0x35, 0x3d, 0xb3, 0x49, 0x98, 0xc9, 0x71, 0x04, 0xdf,
+	0x8b, 0x09, 0xde, 0x82, 0x3c, 0x9f, 0xcb, 0x37, 0xba, 0x38, 0x10, 0x7a, 0x02, 0xfc, 0x5b, 0x58,
+	0x51, 0xcd, 0x4b, 0xa2, 0xea, 0xd7, 0x25, 0x2a, 0x75, 0x4e, 0x0f, 0xb8, 0x38, 0x96, 0xad, 0xbf,
+	0x6b, 0x80, 0xb8, 0x79, 0x83, 0xb8, 0xfe, 0x19, 0xb9, 0xce, 0xc5, 0x36, 0x08, 0xff, 0xa6, 0xb8,
+	0xd2, 0xfa, 0xb9, 0xfa, 0x0d, 0x2c, 0x2b, 0xd6, 0xdf, 0x29, 0x55, 0x49, 0x62, 0x55, 0x48, 0xc3,
+	0x8c, 0x1c, 0x7a, 0xed, 0xc4, 0x52, 0xf1, 0xef, 0x8c, 0xac, 0x38, 0xb1, 0x12, 0xf3, 0xef, 0x94,
+	0xad, 0xcf, 0x61, 0xf6, 0x07, 0xe7, 0xf4, 0x85, 0x7f, 0x4a, 0x3c, 0x74, 0x07, 0xe6, 0x4c, 0xcb,
+	0x22, 0x61, 0x58, 0xa3, 0xac, 0x2f, 0x5d, 0x2d, 0x08, 0x99, 0x80, 0x20, 0x98, 0xa4, 0x17, 0xed,
+	0x78, 0x7b, 0xf0, 0x36, 0xba, 0x0d, 0x40, 0xbe, 0x68, 0xdb, 0x01, 0x09, 0x6b, 0xb6, 0x38, 0x61,
+	0x26, 0x8c, 0xbc, 0x94, 0x1c, 0x7b, 0xf8, 0x29, 0x2c, 0x3f, 0x8e, 0x68, 0x8b, 0x78, 0xd4, 0xb6,
+	0x4c, 0x9a, 0xa4, 0xae, 0x0e, 0xb3, 0x51, 0x48, 0x82, 0x14, 0x27, 0x49, 0x9f, 0x8d, 0xb5, 0xcd,
+	0x30, 0x3c, 0xf7, 0x83, 0xba, 0xb4, 0x94, 0xf4, 0xf1, 0x01, 0xac, 0xa8, 0xea, 0x24, 0x5d, 0x1f,
+	0xc0, 0xd4, 0x8b, 0xc4, 0xeb, 0xc2, 0xde, 0x4a, 0x9a, 0xad, 0x78, 0x85, 0x86, 0x80, 0xe0, 0x25,
+	0x58, 0x30, 0x48, 0x23, 0x20, 0x61, 0x4b, 0x7a, 0x83, 0xbf, 0x0b, 0x8b, 0x89, 0xe4, 0x2d, 0x14,
+	0xd6, 0x41, 0xaf, 0x9a, 0x67, 0xe4, 0xd0, 0x8f, 0x3c, 0x4a, 0x82, 0x03, 0x33, 0x24, 0x8e, 0xed,
+	0x25, 0x4b, 0x45, 0x30, 0x99, 0x5a, 0x26, 0x6f, 0xb3, 0x9c, 0x60, 0xbf, 0x61, 0xdb, 0xb4, 0x92,
+	0xc3, 0x26, 0x11, 0xb0, 0xeb, 0xb1, 0x6d, 0xd2, 0x56, 0xb8, 0x31, 0x51, 0x9e, 0xd8, 0xce, 0x1b,
+	0xa2, 0x83, 0x1f, 0xc1, 0x66, 0xa6, 0x15, 0xe9, 0xf0, 0x2d, 0x98, 0xf5, 0x22, 0xb7, 0x76, 0x4a,
+	0x2e, 0x44, 0x81, 0x3d, 0x6f, 0xcc, 0x78, 0x91, 0xfb, 0x43, 0x72, 0x11, 0xe2, 0x3d, 0xd8, 0xaa,
+	0x10, 0x87, 0xd0, 0x37, 0xf0, 0x10, 0x97, 0xe0, 0xf6, 0x90, 0x39, 0xb2, 0x98, 0xf8, 0x0c, 0x16,
+	0x0f, 0x7d, 0xd7, 0xb5, 0x29, 0xbf, 0x3a, 0x02, 0x97, 0xd4, 0xd1, 0x7d, 0x58, 0xa4, 0xb6, 0x4b,
+	0xfc, 0x88, 0xd6, 0x42, 0x62, 0xf9, 0x5e, 0x3d, 0xf6, 0x64, 0x41, 0x8a, 0xab, 0x42, 0x8a, 0x36,
+	0x21, 0x6f, 0xf1, 0xb9, 0x35, 0x3b, 0x09, 0xb1, 0x10, 0x1c, 0xd7, 0xf1, 0x3e, 0xac, 0x48, 0x95,
+	0x42, 0x7f, 0xec, 0xa5, 0x32, 0x49, 0xeb, 0x9b, 0xb4, 0x0e, 0xab, 0x7d, 0x93, 0xa4, 0x9b, 0x7b,
+	0xb0, 0x7c, 0x68, 0x7a, 0x16, 0x71, 0xde, 0x40, 0xd9, 0x1a, 0xac, 0xa8, 0x73, 0xa4, 0xae, 0x4d,
+	0xb8, 0xf5, 0x23, 0x3b, 0xa4, 0x9f, 0x12, 0xaf, 0x6e, 0x7b, 0x4d, 0x31, 0x18, 0x57, 0xa9, 0xf8,
+	0xd7, 0x30, 0xaf, 0x0c, 0x8c, 0x34, 0xc1, 0x28, 0x67, 0xf9, 0x1e, 0xef, 0x24, 0xd6, 0x66, 0x49,
+	0xc1, 0x78, 0x0a, 0xa9, 0xe9, 0xb6, 0xe3, 0x8d, 0x94, 0x08, 0xd8, 0xae, 0xa8, 0x13, 0xb3, 0xce,
+	0x62, 0xc0, 0xeb, 0xd0, 0x09, 0x23, 0xe9, 0xe3, 0x1f, 0x83, 0x9e, 0xe5, 0x98, 0xcc, 0x8c, 0x7d,
+	0x98, 0x11, 0x76, 0x59, 0x38, 0x26, 0xb6, 0x0b, 0x7b, 0xb7, 0xd2, 0xc9, 0xac, 0x4c, 0x32, 0x62,
+	0x24, 0x3e, 0x82, 0x9b, 0x87, 0x2d, 0x62, 0x9d, 0xb6, 0x7d, 0xdb, 0xa3, 0x6f, 0x9d, 0xca, 0xb8,
+	0x0a, 0x28, 0xad, 0x46, 0x7a, 0xa4, 0xac, 0x54, 0xeb, 0x5f, 0x69, 0x09, 0x0a, 0x2c, 0x93, 0x89,
+	0x47, 0x03, 0x9b, 0x84, 0x5c, 0xe7, 0xbc, 0x01, 0x5e, 0xe4, 0x1e, 0x09, 0x09, 0xde, 0x80, 0x35,
+	0xb6, 0xdc, 0x9e, 0xe2, 0x24, 0x08, 0xbf, 0xd7, 0x60, 0xa1, 0x27, 0x3e, 0xf6, 0x1a, 0xfe, 0x5b,
+	0x6c, 0xbf, 0xd1, 0x71, 0xe8, 0xf3, 0x6e, 0x72, 0xc0, 0xbb, 0xcf, 0x60, 0x7d, 0xc0, 0x3b, 0xb9,
+	0xee, 0xef, 0x40, 0xc1, 0xea, 0x89, 0x65, 0x34, 0x74, 0xa5, 0x7a, 0x54, 0x9c, 0x37, 0xd2, 0x70,
+	0xfc, 0x21, 0xac, 0x56, 0xec, 0x46, 0xe3, 0x5a, 0x61, 0xc1, 0x7f, 0x9c, 0x48, 0x33, 0xc1, 0xe6,
+	0xb1, 0x63, 0x85, 0x9a, 0x27, 0x4e, 0x8c, 0x13, 0x1d, 0xb4, 0x04, 0x13, 0xa7, 0x24, 0x7e, 0x02,
+	0xb1, 0x26, 0xfa, 0x36, 0x4c, 0x5b, 0x2d, 0xd3, 0x6b, 0x12, 0xbe, 0xf8, 0x85, 0xbd, 0x3b, 0xd9,
+	0x0e, 0x32, 0x9d, 0xbb, 0x87, 0x1c, 0x68, 0xc8, 0x09, 0xe8, 0x11, 0x4c, 0x37, 0x6c, 0xe2, 0xd4,
+	0x19, 0x2f, 0x6c, 0x6d, 0xe5, 0x11, 0x53, 0x9f, 0x30, 0xa0, 0x21, 0xf1, 0xfa, 0x97, 0x1a, 0x4c,
+	0x71, 0x09, 0x7f, 0xac, 0xb1, 0x46, 0xec, 0x26, 0xef, 0xa4, 0x9c, 0xba, 0xf1, 0xa6, 0x4e, 0x3d,
+	0x80, 0xa5, 0x1e, 0x8d, 0xb5, 0x33, 0xd3, 0x89, 0x88, 0xac, 0x84, 0x17, 0x7b, 0xf2, 0x97, 0x4c,
+	0x8c, 0xee, 0xc2, 0xbc, 0x15, 0x05, 0x01, 0x49, 0x70, 0xe2, 0xc5, 0x37, 0x27, 0x85, 0x1c, 0x84,
+	0x1f, 0xc2, 0xb4, 0xb0, 0x80, 0xe6, 0x60, 0xf6, 0xe9, 0xf3, 0xca, 0xf1, 0x93, 0xe3, 0xa3, 0xca,
+	0x52, 0x0e, 0xe5, 0x61, 0xea, 0x71, 0xa5, 0x72, 0x54, 0x59, 0xd2, 0x50, 0x01, 0x66, 0x8c, 0xa3,
+	0xa7, 0xcf, 0x5f, 0x1e, 0x55, 0x96, 0x6e, 0xe8, 0x93, 0x7f, 0xf8, 0x5b, 0x51, 0xc3, 0xcf, 0x60,
+	0xad, 0x3f, 0x7a, 0x32, 0x2b, 0x1e, 0xc2, 0x4c, 0x9c, 0x4d, 0x23, 0x33, 0x82, 0x4d, 0x37, 0x62,
+	0x28, 0xbe, 0x07, 0x8b, 0x86, 0xef, 0x38, 0x27, 0xa6, 0x75, 0x3a, 0x2a, 0x0f, 0xf6, 0x61, 0xa9,
+	0x07, 0x93, 0x06, 0xfb, 0x52, 0x58, 0x1b, 0x48, 0xe1, 0x03, 0x98, 0x7f, 0x6c, 0x51, 0xdb, 0xf7,
+	0x52, 0x9a, 0xd9, 0x25, 0x14, 0x6b, 0x66, 0x6d, 0x76, 0xf1, 0xff, 0x32, 0xf4, 0xbd, 0x9a, 0x28,
+	0xab, 0xe4, 0x2e, 0x62, 0x12, 0x5e, 0xf6, 0xe0, 0x8f, 0x61, 0x21, 0xd6, 0xd1, 0x33, 0xcb, 0x27,
+	0xa4, 0xea, 0x9a, 0xbc, 0xc1, 0x75, 0x88, 0x52, 0x66, 0xef, 0x72, 0x0e, 0xe6, 0x78, 0x69, 0x53,
+	0x25, 0xc1, 0x99, 0x6d, 0x11, 0xf4, 0x02, 0x16, 0xab, 0x2d, 0xff, 0x3c, 0xf5, 0xf9, 0x03, 0x15,
+	0x47, 0x7f, 0xbd, 0xd1, 0x4b, 0x63, 0xbe, 0x9b, 0xe0, 0x1c, 0x7a, 0x04, 0x13, 0xd5, 0xc8, 0x45,
+	0x6b, 0xd9, 0xef, 0x5d, 0x7d, 0x7d, 0xc8, 0x33, 0x13, 0xe7, 0xd0, 0x53, 0x80, 0xde, 0x63, 0x09,
+	0xdd, 0x1e, 0xf9, 0xec, 0xd3, 0x8b, 0xc3, 0x86, 0x13, 0x75, 0x55, 0x98, 0x4b, 0x3f, 0x2a, 0x50,
+	0x69, 0xcc, 0x6b, 0x47, 0x2f, 0x0f, 0x07, 0x24, 0x4a, 0x3f, 0x85, 0x42, 0xaa, 0xfa, 0x56, 0xf9,
+	0x1a, 0x7c, 0x14, 0xe8, 0xa5, 0xa1, 0xe3, 0x03, 0x6e, 0xca, 0x12, 0x35, 0xc3, 0x4d, 0xb5, 0x76,
+	0xd6, 0xcb, 0xc3, 0x01, 0x69, 0xa5, 0xe9, 0x42, 0x4e, 0x55, 0x9a, 0x51, 0x31, 0xea, 0xe5, 0xe1,
+	0x80, 0x44, 0x69, 0x05, 0x66, 0x64, 0x1d, 0x87, 0x94, 0x3d, 0xa4, 0x96, 0x7b, 0xfa, 0x66, 0xe6,
+	0x58, 0xa2, 0xe5, 0xa7, 0xb0, 0xa0, 0x7e, 0xa2, 0x41, 0x77, 0xc6, 0x7e, 0xa4, 0xd2, 0xf1, 0xf8,
+	0x2f, 0x3c, 0x38, 0x87, 0x5a, 0xb0, 0x9c, 0x51, 0xc3, 0x21, 0xe5, 0x63, 0xdf, 0xf0, 0x52, 0x52,
+	0xbf, 0x3f, 0x16, 0x97, 0x58, 0xf2, 0x60, 0x35, 0xb3, 0x7e, 0x43, 0xdb, 0x69, 0x1d, 0xa3, 0xca,
+	0x42, 0xfd, 0xc1, 0x35, 0x90, 0x89, 0xbd, 0x97, 0x30, 0xaf, 0x14, 0x60, 0x48, 0x3d, 0xfa, 0x33,
+	0x0a, 0x3a, 0xfd, 0xce, 0x08, 0x44, 0x3a, 0x4f, 0xd2, 0xb5, 0x98, 0x9a, 0x27, 0x19, 0x95, 0x9d,
+	0x5e, 0x1e, 0x0e, 0x48, 0x94, 0x12, 0x40, 0x83, 0xf5, 0x12, 0x52, 0xde, 0x84, 0x43, 0x0b, 0x3d,
+	0xfd, 0xfd, 0x71, 0x30, 0xe5, 0xb8, 0x48, 0x4e, 0xef, 0xbe, 0xe3, 0xa2, 0xff, 0x12, 0xd7, 0x8b,
+	0xc3, 0x86, 0x13, 0x75, 0x3f, 0x87, 0xc5, 0xbe, 0xc2, 0x02, 0xe1, 0x7e, 0x5f, 0x06, 0x6b, 0x22,
+	0xfd, 0xee, 0x48, 0x4c, 0x3a, 0xeb, 0xd5, 0xfb, 0x49, 0xcd, 0xfa, 0xcc, 0xca, 0x43, 0xc7, 0xa3,
+	0x20, 0x89, 0xea, 0xef, 0xc1, 0x6c, 0x7c, 0x07, 0x21, 0x75, 0xef, 0xa9, 0x17, 0x98, 0xbe, 0x95,
+	0x3d, 0x98, 0x28, 0x7a, 0x0c, 0xd3, 0xe2, 0x4e, 0x41, 0x4a, 0x09, 0xab, 0xdc, 0x55, 0xba, 0x9e,
+	0x35, 0x14, 0xab, 0x38, 0x78, 0xf8, 0xea, 0xb2, 0x98, 0xfb, 0xea, 0xb2, 0x98, 0xfb, 0xfa, 0xb2,
+	0xa8, 0xfd, 0xee, 0xaa, 0xa8, 0x7d, 0x79, 0x55, 0xd4, 0xfe, 0x75, 0x55, 0xd4, 0x5e, 0x5d, 0x15,
+	0xb5, 0x7f, 0x5f, 0x15, 0xb5, 0xff, 0x5e, 0x15, 0x73, 0x5f, 0x5f, 0x15, 0xb5, 0x3f, 0xbd, 0x2e,
+	0xe6, 0x5e, 0xbd, 0x2e, 0xe6, 0xbe, 0x7a, 0x5d, 0xcc, 0x9d, 0x4c, 0xf3, 0x7f, 0x34, 0xf6, 0xff,
+	0x3f, 0x00, 0xde, 0x0b, 0xc1, 0x64, 0x1b, 0x19, 0x00, 0x00,
+}
+
+func (x CheckpointDiff_Change) String() string {
+	s, ok := CheckpointDiff_Change_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *SonicOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SonicOutput)
+	if !ok {
+		that2, ok := that.(SonicOutput)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2142,25 +2838,22 @@ func (this *JwtToken) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.AccessToken != that1.AccessToken {
-		return false
-	}
-	if this.Type != that1.Type {
+	if this.Status != that1.Status {
 		return false
 	}
-	if this.ExpiresIn != that1.ExpiresIn {
+	if this.StatusDetail != that1.StatusDetail {
 		return false
 	}
 	return true
 }
-func (this *AuthenticateRequest) Equal(that interface{}) bool {
+func (this *TechsupportRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AuthenticateRequest)
+	that1, ok := that.(*TechsupportRequest)
 	if !ok {
-		that2, ok := that.(AuthenticateRequest)
+		that2, ok := that.(TechsupportRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2172,22 +2865,19 @@ func (this *AuthenticateRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Username != that1.Username {
-		return false
-	}
-	if this.Password != that1.Password {
+	if !this.Input.Equal(that1.Input) {
 		return false
 	}
 	return true
 }
-func (this *AuthenticateResponse) Equal(that interface{}) bool {
+func (this *TechsupportRequest_Input) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AuthenticateResponse)
+	that1, ok := that.(*TechsupportRequest_Input)
 	if !ok {
-		that2, ok := that.(AuthenticateResponse)
+		that2, ok := that.(TechsupportRequest_Input)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2199,19 +2889,19 @@ func (this *AuthenticateResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Token.Equal(that1.Token) {
+	if this.Date != that1.Date {
 		return false
 	}
 	return true
 }
-func (this *RefreshRequest) Equal(that interface{}) bool {
+func (this *TechsupportResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RefreshRequest)
+	that1, ok := that.(*TechsupportResponse)
 	if !ok {
-		that2, ok := that.(RefreshRequest)
+		that2, ok := that.(TechsupportResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2223,16 +2913,19 @@ func (this *RefreshRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.Output.Equal(that1.Output) {
+		return false
+	}
 	return true
 }
-func (this *RefreshResponse) Equal(that interface{}) bool {
+func (this *TechsupportResponse_Output) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RefreshResponse)
+	that1, ok := that.(*TechsupportResponse_Output)
 	if !ok {
-		that2, ok := that.(RefreshResponse)
+		that2, ok := that.(TechsupportResponse_Output)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2244,2522 +2937,8104 @@ func (this *RefreshResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Token.Equal(that1.Token) {
+	if this.OutputFilename != that1.OutputFilename {
 		return false
 	}
 	return true
 }
-func (this *SonicOutput) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ClearNeighborsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&gnoi_sonic.SonicOutput{")
-	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
-	s = append(s, "StatusDetail: "+fmt.Sprintf("%#v", this.StatusDetail)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TechsupportRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ClearNeighborsRequest)
+	if !ok {
+		that2, ok := that.(ClearNeighborsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.TechsupportRequest{")
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TechsupportRequest_Input) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Input.Equal(that1.Input) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.TechsupportRequest_Input{")
-	s = append(s, "Date: "+fmt.Sprintf("%#v", this.Date)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *TechsupportResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.TechsupportResponse{")
-	if this.Output != nil {
-		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
+func (this *ClearNeighborsRequest_Input) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TechsupportResponse_Output) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ClearNeighborsRequest_Input)
+	if !ok {
+		that2, ok := that.(ClearNeighborsRequest_Input)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.TechsupportResponse_Output{")
-	s = append(s, "OutputFilename: "+fmt.Sprintf("%#v", this.OutputFilename)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ClearNeighborsRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.ClearNeighborsRequest{")
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	if this.Force != that1.Force {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ClearNeighborsRequest_Input) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Family != that1.Family {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&gnoi_sonic.ClearNeighborsRequest_Input{")
-	s = append(s, "Force: "+fmt.Sprintf("%#v", this.Force)+",\n")
-	s = append(s, "Family: "+fmt.Sprintf("%#v", this.Family)+",\n")
-	s = append(s, "Ip: "+fmt.Sprintf("%#v", this.Ip)+",\n")
-	s = append(s, "Ifname: "+fmt.Sprintf("%#v", this.Ifname)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ClearNeighborsResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Ip != that1.Ip {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.ClearNeighborsResponse{")
-	if this.Output != nil {
-		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
+	if this.Ifname != that1.Ifname {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ClearNeighborsResponse_Output) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ClearNeighborsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.ClearNeighborsResponse_Output{")
-	s = append(s, "Response: "+fmt.Sprintf("%#v", this.Response)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SumRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ClearNeighborsResponse)
+	if !ok {
+		that2, ok := that.(ClearNeighborsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.SumRequest{")
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SumRequest_Input) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Output.Equal(that1.Output) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&gnoi_sonic.SumRequest_Input{")
-	s = append(s, "Left: "+fmt.Sprintf("%#v", this.Left)+",\n")
-	s = append(s, "Right: "+fmt.Sprintf("%#v", this.Right)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *SumResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.SumResponse{")
-	if this.Output != nil {
-		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
+func (this *ClearNeighborsResponse_Output) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SumResponse_Output) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ClearNeighborsResponse_Output)
+	if !ok {
+		that2, ok := that.(ClearNeighborsResponse_Output)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.SumResponse_Output{")
-	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CopyConfigRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.CopyConfigRequest{")
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	if this.Response != that1.Response {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *CopyConfigRequest_Input) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *SumRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&gnoi_sonic.CopyConfigRequest_Input{")
-	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
-	s = append(s, "Overwrite: "+fmt.Sprintf("%#v", this.Overwrite)+",\n")
-	s = append(s, "Destination: "+fmt.Sprintf("%#v", this.Destination)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CopyConfigResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*SumRequest)
+	if !ok {
+		that2, ok := that.(SumRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.CopyConfigResponse{")
-	if this.Output != nil {
-		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !this.Input.Equal(that1.Input) {
+		return false
+	}
+	return true
 }
-func (this *ImageInstallRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *SumRequest_Input) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.ImageInstallRequest{")
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+
+	that1, ok := that.(*SumRequest_Input)
+	if !ok {
+		that2, ok := that.(SumRequest_Input)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ImageInstallRequest_Input) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.ImageInstallRequest_Input{")
-	s = append(s, "Imagename: "+fmt.Sprintf("%#v", this.Imagename)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ImageInstallResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Left != that1.Left {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.ImageInstallResponse{")
-	if this.Output != nil {
-		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
+	if this.Right != that1.Right {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ImageRemoveRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *SumResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.ImageRemoveRequest{")
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+
+	that1, ok := that.(*SumResponse)
+	if !ok {
+		that2, ok := that.(SumResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ImageRemoveRequest_Input) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.ImageRemoveRequest_Input{")
-	s = append(s, "Imagename: "+fmt.Sprintf("%#v", this.Imagename)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !this.Output.Equal(that1.Output) {
+		return false
+	}
+	return true
 }
-func (this *ImageRemoveResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *SumResponse_Output) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.ImageRemoveResponse{")
-	if this.Output != nil {
-		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
+
+	that1, ok := that.(*SumResponse_Output)
+	if !ok {
+		that2, ok := that.(SumResponse_Output)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ImageDefaultRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.ImageDefaultRequest{")
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	if this.Result != that1.Result {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ImageDefaultRequest_Input) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *CopyConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.ImageDefaultRequest_Input{")
-	s = append(s, "Imagename: "+fmt.Sprintf("%#v", this.Imagename)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ImageDefaultResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*CopyConfigRequest)
+	if !ok {
+		that2, ok := that.(CopyConfigRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.ImageDefaultResponse{")
-	if this.Output != nil {
-		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *JwtToken) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Input.Equal(that1.Input) {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&gnoi_sonic.JwtToken{")
-	s = append(s, "AccessToken: "+fmt.Sprintf("%#v", this.AccessToken)+",\n")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "ExpiresIn: "+fmt.Sprintf("%#v", this.ExpiresIn)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *AuthenticateRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *CopyConfigRequest_Input) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&gnoi_sonic.AuthenticateRequest{")
-	s = append(s, "Username: "+fmt.Sprintf("%#v", this.Username)+",\n")
-	s = append(s, "Password: "+fmt.Sprintf("%#v", this.Password)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AuthenticateResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*CopyConfigRequest_Input)
+	if !ok {
+		that2, ok := that.(CopyConfigRequest_Input)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.AuthenticateResponse{")
-	if this.Token != nil {
-		s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RefreshRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Source != that1.Source {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&gnoi_sonic.RefreshRequest{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RefreshResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Overwrite != that1.Overwrite {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&gnoi_sonic.RefreshResponse{")
-	if this.Token != nil {
-		s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	if this.Destination != that1.Destination {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func valueToGoStringSonic(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (this *CopyConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// SonicServiceClient is the client API for SonicService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SonicServiceClient interface {
-	ShowTechsupport(ctx context.Context, in *TechsupportRequest, opts ...grpc.CallOption) (*TechsupportResponse, error)
-	Sum(ctx context.Context, in *SumRequest, opts ...grpc.CallOption) (*SumResponse, error)
-	CopyConfig(ctx context.Context, in *CopyConfigRequest, opts ...grpc.CallOption) (*CopyConfigResponse, error)
-	ImageInstall(ctx context.Context, in *ImageInstallRequest, opts ...grpc.CallOption) (*ImageInstallResponse, error)
-	ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error)
-	ImageDefault(ctx context.Context, in *ImageDefaultRequest, opts ...grpc.CallOption) (*ImageDefaultResponse, error)
-	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
-	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error)
-	ClearNeighbors(ctx context.Context, in *ClearNeighborsRequest, opts ...grpc.CallOption) (*ClearNeighborsResponse, error)
-}
-
-type sonicServiceClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewSonicServiceClient(cc *grpc.ClientConn) SonicServiceClient {
-	return &sonicServiceClient{cc}
-}
 
-func (c *sonicServiceClient) ShowTechsupport(ctx context.Context, in *TechsupportRequest, opts ...grpc.CallOption) (*TechsupportResponse, error) {
-	out := new(TechsupportResponse)
-	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/ShowTechsupport", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*CopyConfigResponse)
+	if !ok {
+		that2, ok := that.(CopyConfigResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *sonicServiceClient) Sum(ctx context.Context, in *SumRequest, opts ...grpc.CallOption) (*SumResponse, error) {
-	out := new(SumResponse)
-	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/Sum", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-func (c *sonicServiceClient) CopyConfig(ctx context.Context, in *CopyConfigRequest, opts ...grpc.CallOption) (*CopyConfigResponse, error) {
-	out := new(CopyConfigResponse)
-	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/CopyConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !this.Output.Equal(that1.Output) {
+		return false
 	}
-	return out, nil
+	return true
 }
-
-func (c *sonicServiceClient) ImageInstall(ctx context.Context, in *ImageInstallRequest, opts ...grpc.CallOption) (*ImageInstallResponse, error) {
-	out := new(ImageInstallResponse)
-	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/ImageInstall", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *sonicServiceClient) ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error) {
-	out := new(ImageRemoveResponse)
-	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/ImageRemove", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *ImageInstallRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *sonicServiceClient) ImageDefault(ctx context.Context, in *ImageDefaultRequest, opts ...grpc.CallOption) (*ImageDefaultResponse, error) {
-	out := new(ImageDefaultResponse)
-	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/ImageDefault", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ImageInstallRequest)
+	if !ok {
+		that2, ok := that.(ImageInstallRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *sonicServiceClient) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
-	out := new(AuthenticateResponse)
-	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/Authenticate", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-func (c *sonicServiceClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error) {
-	out := new(RefreshResponse)
-	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/Refresh", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !this.Input.Equal(that1.Input) {
+		return false
 	}
-	return out, nil
+	return true
 }
-
-func (c *sonicServiceClient) ClearNeighbors(ctx context.Context, in *ClearNeighborsRequest, opts ...grpc.CallOption) (*ClearNeighborsResponse, error) {
-	out := new(ClearNeighborsResponse)
-	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/ClearNeighbors", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *ImageInstallRequest_Input) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
-
-// SonicServiceServer is the server API for SonicService service.
-type SonicServiceServer interface {
-	ShowTechsupport(context.Context, *TechsupportRequest) (*TechsupportResponse, error)
-	Sum(context.Context, *SumRequest) (*SumResponse, error)
-	CopyConfig(context.Context, *CopyConfigRequest) (*CopyConfigResponse, error)
-	ImageInstall(context.Context, *ImageInstallRequest) (*ImageInstallResponse, error)
-	ImageRemove(context.Context, *ImageRemoveRequest) (*ImageRemoveResponse, error)
-	ImageDefault(context.Context, *ImageDefaultRequest) (*ImageDefaultResponse, error)
-	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
-	Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error)
-	ClearNeighbors(context.Context, *ClearNeighborsRequest) (*ClearNeighborsResponse, error)
-}
-
-// UnimplementedSonicServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedSonicServiceServer struct {
-}
-
-func (*UnimplementedSonicServiceServer) ShowTechsupport(ctx context.Context, req *TechsupportRequest) (*TechsupportResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShowTechsupport not implemented")
-}
-func (*UnimplementedSonicServiceServer) Sum(ctx context.Context, req *SumRequest) (*SumResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sum not implemented")
-}
-func (*UnimplementedSonicServiceServer) CopyConfig(ctx context.Context, req *CopyConfigRequest) (*CopyConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CopyConfig not implemented")
-}
-func (*UnimplementedSonicServiceServer) ImageInstall(ctx context.Context, req *ImageInstallRequest) (*ImageInstallResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImageInstall not implemented")
-}
-func (*UnimplementedSonicServiceServer) ImageRemove(ctx context.Context, req *ImageRemoveRequest) (*ImageRemoveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImageRemove not implemented")
-}
-func (*UnimplementedSonicServiceServer) ImageDefault(ctx context.Context, req *ImageDefaultRequest) (*ImageDefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImageDefault not implemented")
-}
-func (*UnimplementedSonicServiceServer) Authenticate(ctx context.Context, req *AuthenticateRequest) (*AuthenticateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
-}
-func (*UnimplementedSonicServiceServer) Refresh(ctx context.Context, req *RefreshRequest) (*RefreshResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
-}
-func (*UnimplementedSonicServiceServer) ClearNeighbors(ctx context.Context, req *ClearNeighborsRequest) (*ClearNeighborsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearNeighbors not implemented")
-}
-
-func RegisterSonicServiceServer(s *grpc.Server, srv SonicServiceServer) {
-	s.RegisterService(&_SonicService_serviceDesc, srv)
-}
 
-func _SonicService_ShowTechsupport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TechsupportRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SonicServiceServer).ShowTechsupport(ctx, in)
+	that1, ok := that.(*ImageInstallRequest_Input)
+	if !ok {
+		that2, ok := that.(ImageInstallRequest_Input)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnoi.sonic.SonicService/ShowTechsupport",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SonicServiceServer).ShowTechsupport(ctx, req.(*TechsupportRequest))
+	if this.Imagename != that1.Imagename {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _SonicService_Sum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SumRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *ImageInstallResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(SonicServiceServer).Sum(ctx, in)
+
+	that1, ok := that.(*ImageInstallResponse)
+	if !ok {
+		that2, ok := that.(ImageInstallResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnoi.sonic.SonicService/Sum",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SonicServiceServer).Sum(ctx, req.(*SumRequest))
+	if !this.Output.Equal(that1.Output) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _SonicService_CopyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CopyConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *ImageRemoveRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(SonicServiceServer).CopyConfig(ctx, in)
+
+	that1, ok := that.(*ImageRemoveRequest)
+	if !ok {
+		that2, ok := that.(ImageRemoveRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnoi.sonic.SonicService/CopyConfig",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SonicServiceServer).CopyConfig(ctx, req.(*CopyConfigRequest))
+	if !this.Input.Equal(that1.Input) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _SonicService_ImageInstall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImageInstallRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *ImageRemoveRequest_Input) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(SonicServiceServer).ImageInstall(ctx, in)
+
+	that1, ok := that.(*ImageRemoveRequest_Input)
+	if !ok {
+		that2, ok := that.(ImageRemoveRequest_Input)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnoi.sonic.SonicService/ImageInstall",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SonicServiceServer).ImageInstall(ctx, req.(*ImageInstallRequest))
+	if this.Imagename != that1.Imagename {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *ImageRemoveResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _SonicService_ImageRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImageRemoveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*ImageRemoveResponse)
+	if !ok {
+		that2, ok := that.(ImageRemoveResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(SonicServiceServer).ImageRemove(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnoi.sonic.SonicService/ImageRemove",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SonicServiceServer).ImageRemove(ctx, req.(*ImageRemoveRequest))
+	if !this.Output.Equal(that1.Output) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _SonicService_ImageDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImageDefaultRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *ImageDefaultRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(SonicServiceServer).ImageDefault(ctx, in)
+
+	that1, ok := that.(*ImageDefaultRequest)
+	if !ok {
+		that2, ok := that.(ImageDefaultRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnoi.sonic.SonicService/ImageDefault",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SonicServiceServer).ImageDefault(ctx, req.(*ImageDefaultRequest))
+	if !this.Input.Equal(that1.Input) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _SonicService_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthenticateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *ImageDefaultRequest_Input) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(SonicServiceServer).Authenticate(ctx, in)
+
+	that1, ok := that.(*ImageDefaultRequest_Input)
+	if !ok {
+		that2, ok := that.(ImageDefaultRequest_Input)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnoi.sonic.SonicService/Authenticate",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SonicServiceServer).Authenticate(ctx, req.(*AuthenticateRequest))
+	if this.Imagename != that1.Imagename {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _SonicService_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *ImageDefaultResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(SonicServiceServer).Refresh(ctx, in)
+
+	that1, ok := that.(*ImageDefaultResponse)
+	if !ok {
+		that2, ok := that.(ImageDefaultResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnoi.sonic.SonicService/Refresh",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SonicServiceServer).Refresh(ctx, req.(*RefreshRequest))
+	if !this.Output.Equal(that1.Output) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *JwtToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _SonicService_ClearNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearNeighborsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*JwtToken)
+	if !ok {
+		that2, ok := that.(JwtToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(SonicServiceServer).ClearNeighbors(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnoi.sonic.SonicService/ClearNeighbors",
+	if this.AccessToken != that1.AccessToken {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SonicServiceServer).ClearNeighbors(ctx, req.(*ClearNeighborsRequest))
+	if this.Type != that1.Type {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _SonicService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "gnoi.sonic.SonicService",
-	HandlerType: (*SonicServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ShowTechsupport",
-			Handler:    _SonicService_ShowTechsupport_Handler,
-		},
-		{
-			MethodName: "Sum",
-			Handler:    _SonicService_Sum_Handler,
-		},
-		{
-			MethodName: "CopyConfig",
-			Handler:    _SonicService_CopyConfig_Handler,
-		},
-		{
-			MethodName: "ImageInstall",
-			Handler:    _SonicService_ImageInstall_Handler,
-		},
-		{
-			MethodName: "ImageRemove",
-			Handler:    _SonicService_ImageRemove_Handler,
-		},
-		{
-			MethodName: "ImageDefault",
-			Handler:    _SonicService_ImageDefault_Handler,
-		},
-		{
-			MethodName: "Authenticate",
-			Handler:    _SonicService_Authenticate_Handler,
-		},
-		{
-			MethodName: "Refresh",
-			Handler:    _SonicService_Refresh_Handler,
-		},
-		{
-			MethodName: "ClearNeighbors",
-			Handler:    _SonicService_ClearNeighbors_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sonic.proto",
-}
-
-func (m *SonicOutput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ExpiresIn != that1.ExpiresIn {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SonicOutput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *AuthenticateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SonicOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StatusDetail) > 0 {
-		i -= len(m.StatusDetail)
-		copy(dAtA[i:], m.StatusDetail)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.StatusDetail)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*AuthenticateRequest)
+	if !ok {
+		that2, ok := that.(AuthenticateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Status != 0 {
-		i = encodeVarintSonic(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TechsupportRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Username != that1.Username {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Password != that1.Password {
+		return false
+	}
+	return true
 }
+func (this *AuthenticateResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *TechsupportRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TechsupportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
+	that1, ok := that.(*AuthenticateResponse)
+	if !ok {
+		that2, ok := that.(AuthenticateResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TechsupportRequest_Input) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TechsupportRequest_Input) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TechsupportRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Date) > 0 {
-		i -= len(m.Date)
-		copy(dAtA[i:], m.Date)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.Date)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Token.Equal(that1.Token) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *TechsupportResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RefreshRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TechsupportResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *TechsupportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Output != nil {
-		{
-			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
+	that1, ok := that.(*RefreshRequest)
+	if !ok {
+		that2, ok := that.(RefreshRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TechsupportResponse_Output) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TechsupportResponse_Output) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *TechsupportResponse_Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OutputFilename) > 0 {
-		i -= len(m.OutputFilename)
-		copy(dAtA[i:], m.OutputFilename)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.OutputFilename)))
-		i--
-		dAtA[i] = 0xa
+func (this *RefreshResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *ClearNeighborsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*RefreshResponse)
+	if !ok {
+		that2, ok := that.(RefreshResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ClearNeighborsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Token.Equal(that1.Token) {
+		return false
+	}
+	return true
 }
+func (this *SaveCounterBaselineRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ClearNeighborsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
+	that1, ok := that.(*SaveCounterBaselineRequest)
+	if !ok {
+		that2, ok := that.(SaveCounterBaselineRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ClearNeighborsRequest_Input) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ClearNeighborsRequest_Input) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ClearNeighborsRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ifname) > 0 {
-		i -= len(m.Ifname)
-		copy(dAtA[i:], m.Ifname)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.Ifname)))
-		i--
-		dAtA[i] = 0x22
+	if this.Name != that1.Name {
+		return false
 	}
-	if len(m.Ip) > 0 {
-		i -= len(m.Ip)
-		copy(dAtA[i:], m.Ip)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.Ip)))
-		i--
-		dAtA[i] = 0x1a
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if len(m.Family) > 0 {
-		i -= len(m.Family)
-		copy(dAtA[i:], m.Family)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.Family)))
-		i--
-		dAtA[i] = 0x12
+	if len(this.Paths) != len(that1.Paths) {
+		return false
 	}
-	if m.Force {
-		i--
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	for i := range this.Paths {
+		if this.Paths[i] != that1.Paths[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *SaveCounterBaselineResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ClearNeighborsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*SaveCounterBaselineResponse)
+	if !ok {
+		that2, ok := that.(SaveCounterBaselineResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ClearNeighborsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumKeys != that1.NumKeys {
+		return false
+	}
+	return true
 }
+func (this *DeleteCounterBaselineRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ClearNeighborsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Output != nil {
-		{
-			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
+	that1, ok := that.(*DeleteCounterBaselineRequest)
+	if !ok {
+		that2, ok := that.(DeleteCounterBaselineRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ClearNeighborsResponse_Output) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ClearNeighborsResponse_Output) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ClearNeighborsResponse_Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Response) > 0 {
-		i -= len(m.Response)
-		copy(dAtA[i:], m.Response)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.Response)))
-		i--
-		dAtA[i] = 0xa
+	if this.Name != that1.Name {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *DeleteCounterBaselineResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SumRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DeleteCounterBaselineResponse)
+	if !ok {
+		that2, ok := that.(DeleteCounterBaselineResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *CommitConfirmed) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SumRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*CommitConfirmed)
+	if !ok {
+		that2, ok := that.(CommitConfirmed)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TimeoutSeconds != that1.TimeoutSeconds {
+		return false
+	}
+	if this.CommitId != that1.CommitId {
+		return false
+	}
+	return true
 }
+func (this *ConfirmCommitRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
+	that1, ok := that.(*ConfirmCommitRequest)
+	if !ok {
+		that2, ok := that.(ConfirmCommitRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CommitId != that1.CommitId {
+		return false
+	}
+	return true
 }
+func (this *ConfirmCommitResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SumRequest_Input) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ConfirmCommitResponse)
+	if !ok {
+		that2, ok := that.(ConfirmCommitResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *CancelCommitRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SumRequest_Input) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*CancelCommitRequest)
+	if !ok {
+		that2, ok := that.(CancelCommitRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CommitId != that1.CommitId {
+		return false
+	}
+	return true
 }
+func (this *CancelCommitResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SumRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Right != 0 {
-		i = encodeVarintSonic(dAtA, i, uint64(m.Right))
-		i--
-		dAtA[i] = 0x10
+	that1, ok := that.(*CancelCommitResponse)
+	if !ok {
+		that2, ok := that.(CancelCommitResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Left != 0 {
-		i = encodeVarintSonic(dAtA, i, uint64(m.Left))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *ListPendingCommitsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SumResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ListPendingCommitsRequest)
+	if !ok {
+		that2, ok := that.(ListPendingCommitsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *PendingCommit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SumResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Output != nil {
-		{
-			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
+	that1, ok := that.(*PendingCommit)
+	if !ok {
+		that2, ok := that.(PendingCommit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SumResponse_Output) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SumResponse_Output) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.CommitId != that1.CommitId {
+		return false
+	}
+	if this.User != that1.User {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
 }
+func (this *ListPendingCommitsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SumResponse_Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Result != 0 {
-		i = encodeVarintSonic(dAtA, i, uint64(m.Result))
-		i--
-		dAtA[i] = 0x8
+	that1, ok := that.(*ListPendingCommitsResponse)
+	if !ok {
+		that2, ok := that.(ListPendingCommitsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Commits) != len(that1.Commits) {
+		return false
+	}
+	for i := range this.Commits {
+		if !this.Commits[i].Equal(that1.Commits[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *CheckpointRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CopyConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*CheckpointRequest)
+	if !ok {
+		that2, ok := that.(CheckpointRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	return true
 }
+func (this *CheckpointResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CopyConfigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*CheckpointResponse)
+	if !ok {
+		that2, ok := that.(CheckpointResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.NumEntries != that1.NumEntries {
+		return false
+	}
+	return true
 }
+func (this *ListCheckpointsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CopyConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
+	that1, ok := that.(*ListCheckpointsRequest)
+	if !ok {
+		that2, ok := that.(ListCheckpointsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *CheckpointInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CopyConfigRequest_Input) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*CheckpointInfo)
+	if !ok {
+		that2, ok := that.(CheckpointInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.NumEntries != that1.NumEntries {
+		return false
+	}
+	return true
 }
+func (this *ListCheckpointsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CopyConfigRequest_Input) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ListCheckpointsResponse)
+	if !ok {
+		that2, ok := that.(ListCheckpointsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Checkpoints) != len(that1.Checkpoints) {
+		return false
+	}
+	for i := range this.Checkpoints {
+		if !this.Checkpoints[i].Equal(that1.Checkpoints[i]) {
+			return false
+		}
+	}
+	return true
 }
-
-func (m *CopyConfigRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.Destination)))
-		i--
-		dAtA[i] = 0x1a
+func (this *DiffCheckpointRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Overwrite {
-		i--
-		if m.Overwrite {
-			dAtA[i] = 1
+
+	that1, ok := that.(*DiffCheckpointRequest)
+	if !ok {
+		that2, ok := that.(DiffCheckpointRequest)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x10
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
 }
+func (this *CheckpointDiff) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CopyConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*CheckpointDiff)
+	if !ok {
+		that2, ok := that.(CheckpointDiff)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CopyConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CopyConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Output != nil {
-		{
-			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Table != that1.Table {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Change != that1.Change {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ImageInstallRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CheckpointDiff_Field) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ImageInstallRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ImageInstallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
+	that1, ok := that.(*CheckpointDiff_Field)
+	if !ok {
+		that2, ok := that.(CheckpointDiff_Field)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ImageInstallRequest_Input) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ImageInstallRequest_Input) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ImageInstallRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Imagename) > 0 {
-		i -= len(m.Imagename)
-		copy(dAtA[i:], m.Imagename)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.Imagename)))
-		i--
-		dAtA[i] = 0x12
+	if this.Field != that1.Field {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ImageInstallResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Change != that1.Change {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ImageInstallResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.CheckpointValue != that1.CheckpointValue {
+		return false
+	}
+	if this.CurrentValue != that1.CurrentValue {
+		return false
+	}
+	return true
 }
+func (this *DiffCheckpointResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ImageInstallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Output != nil {
-		{
-			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
+	that1, ok := that.(*DiffCheckpointResponse)
+	if !ok {
+		that2, ok := that.(DiffCheckpointResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ImageRemoveRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ImageRemoveRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ImageRemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *RollbackRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ImageRemoveRequest_Input) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*RollbackRequest)
+	if !ok {
+		that2, ok := that.(RollbackRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
 }
+func (this *RollbackResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ImageRemoveRequest_Input) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*RollbackResponse)
+	if !ok {
+		that2, ok := that.(RollbackResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumEntries != that1.NumEntries {
+		return false
+	}
+	return true
 }
+func (this *ActionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ImageRemoveRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Imagename) > 0 {
-		i -= len(m.Imagename)
-		copy(dAtA[i:], m.Imagename)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.Imagename)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*ActionRequest)
+	if !ok {
+		that2, ok := that.(ActionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ImageRemoveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ImageRemoveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.JsonInput != that1.JsonInput {
+		return false
+	}
+	return true
 }
+func (this *ActionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ImageRemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Output != nil {
-		{
-			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
+	that1, ok := that.(*ActionResponse)
+	if !ok {
+		that2, ok := that.(ActionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ImageDefaultRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ImageDefaultRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.JsonOutput != that1.JsonOutput {
+		return false
+	}
+	return true
 }
-
-func (m *ImageDefaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (this *SonicOutput) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 6)
+	s = append(s, "&gnoi_sonic.SonicOutput{")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "StatusDetail: "+fmt.Sprintf("%#v", this.StatusDetail)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageDefaultRequest_Input) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TechsupportRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.TechsupportRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageDefaultRequest_Input) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *TechsupportRequest_Input) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.TechsupportRequest_Input{")
+	s = append(s, "Date: "+fmt.Sprintf("%#v", this.Date)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageDefaultRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Imagename) > 0 {
-		i -= len(m.Imagename)
-		copy(dAtA[i:], m.Imagename)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.Imagename)))
-		i--
-		dAtA[i] = 0xa
+func (this *TechsupportResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.TechsupportResponse{")
+	if this.Output != nil {
+		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageDefaultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TechsupportResponse_Output) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.TechsupportResponse_Output{")
+	s = append(s, "OutputFilename: "+fmt.Sprintf("%#v", this.OutputFilename)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageDefaultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *ClearNeighborsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ClearNeighborsRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageDefaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Output != nil {
-		{
-			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (this *ClearNeighborsRequest_Input) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 8)
+	s = append(s, "&gnoi_sonic.ClearNeighborsRequest_Input{")
+	s = append(s, "Force: "+fmt.Sprintf("%#v", this.Force)+",\n")
+	s = append(s, "Family: "+fmt.Sprintf("%#v", this.Family)+",\n")
+	s = append(s, "Ip: "+fmt.Sprintf("%#v", this.Ip)+",\n")
+	s = append(s, "Ifname: "+fmt.Sprintf("%#v", this.Ifname)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *JwtToken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ClearNeighborsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ClearNeighborsResponse{")
+	if this.Output != nil {
+		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *JwtToken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *ClearNeighborsResponse_Output) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ClearNeighborsResponse_Output{")
+	s = append(s, "Response: "+fmt.Sprintf("%#v", this.Response)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *JwtToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpiresIn != 0 {
-		i = encodeVarintSonic(dAtA, i, uint64(m.ExpiresIn))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
+func (this *SumRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.AccessToken) > 0 {
-		i -= len(m.AccessToken)
-		copy(dAtA[i:], m.AccessToken)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.AccessToken)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.SumRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AuthenticateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SumRequest_Input) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AuthenticateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 6)
+	s = append(s, "&gnoi_sonic.SumRequest_Input{")
+	s = append(s, "Left: "+fmt.Sprintf("%#v", this.Left)+",\n")
+	s = append(s, "Right: "+fmt.Sprintf("%#v", this.Right)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AuthenticateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x12
+func (this *SumResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintSonic(dAtA, i, uint64(len(m.Username)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.SumResponse{")
+	if this.Output != nil {
+		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AuthenticateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SumResponse_Output) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.SumResponse_Output{")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Token != nil {
-		{
-			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (this *CopyConfigRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RefreshRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.CopyConfigRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RefreshRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RefreshRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RefreshResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CopyConfigRequest_Input) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RefreshResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 7)
+	s = append(s, "&gnoi_sonic.CopyConfigRequest_Input{")
+	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	s = append(s, "Overwrite: "+fmt.Sprintf("%#v", this.Overwrite)+",\n")
+	s = append(s, "Destination: "+fmt.Sprintf("%#v", this.Destination)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RefreshResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Token != nil {
-		{
-			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSonic(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (this *CopyConfigResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintSonic(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSonic(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.CopyConfigResponse{")
+	if this.Output != nil {
+		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *SonicOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovSonic(uint64(m.Status))
+func (this *ImageInstallRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.StatusDetail)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ImageInstallRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TechsupportRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovSonic(uint64(l))
+func (this *ImageInstallRequest_Input) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ImageInstallRequest_Input{")
+	s = append(s, "Imagename: "+fmt.Sprintf("%#v", this.Imagename)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TechsupportRequest_Input) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ImageInstallResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Date)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ImageInstallResponse{")
+	if this.Output != nil {
+		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TechsupportResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ImageRemoveRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Output != nil {
-		l = m.Output.Size()
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ImageRemoveRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TechsupportResponse_Output) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OutputFilename)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+func (this *ImageRemoveRequest_Input) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ImageRemoveRequest_Input{")
+	s = append(s, "Imagename: "+fmt.Sprintf("%#v", this.Imagename)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ClearNeighborsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ImageRemoveResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ImageRemoveResponse{")
+	if this.Output != nil {
+		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ClearNeighborsRequest_Input) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Force {
-		n += 2
-	}
-	l = len(m.Family)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+func (this *ImageDefaultRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.Ip)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ImageDefaultRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
 	}
-	l = len(m.Ifname)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ImageDefaultRequest_Input) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ImageDefaultRequest_Input{")
+	s = append(s, "Imagename: "+fmt.Sprintf("%#v", this.Imagename)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ClearNeighborsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ImageDefaultResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Output != nil {
-		l = m.Output.Size()
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ImageDefaultResponse{")
+	if this.Output != nil {
+		s = append(s, "Output: "+fmt.Sprintf("%#v", this.Output)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ClearNeighborsResponse_Output) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *JwtToken) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Response)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&gnoi_sonic.JwtToken{")
+	s = append(s, "AccessToken: "+fmt.Sprintf("%#v", this.AccessToken)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ExpiresIn: "+fmt.Sprintf("%#v", this.ExpiresIn)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuthenticateRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 6)
+	s = append(s, "&gnoi_sonic.AuthenticateRequest{")
+	s = append(s, "Username: "+fmt.Sprintf("%#v", this.Username)+",\n")
+	s = append(s, "Password: "+fmt.Sprintf("%#v", this.Password)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *SumRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *AuthenticateResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.AuthenticateResponse{")
+	if this.Token != nil {
+		s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *SumRequest_Input) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *RefreshRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Left != 0 {
-		n += 1 + sovSonic(uint64(m.Left))
+	s := make([]string, 0, 4)
+	s = append(s, "&gnoi_sonic.RefreshRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RefreshResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Right != 0 {
-		n += 1 + sovSonic(uint64(m.Right))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.RefreshResponse{")
+	if this.Token != nil {
+		s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *SumResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *SaveCounterBaselineRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Output != nil {
-		l = m.Output.Size()
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&gnoi_sonic.SaveCounterBaselineRequest{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Paths: "+fmt.Sprintf("%#v", this.Paths)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SaveCounterBaselineResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.SaveCounterBaselineResponse{")
+	s = append(s, "NumKeys: "+fmt.Sprintf("%#v", this.NumKeys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *SumResponse_Output) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *DeleteCounterBaselineRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Result != 0 {
-		n += 1 + sovSonic(uint64(m.Result))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.DeleteCounterBaselineRequest{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteCounterBaselineResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 4)
+	s = append(s, "&gnoi_sonic.DeleteCounterBaselineResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CopyConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *CommitConfirmed) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovSonic(uint64(l))
-	}
-	return n
+	s := make([]string, 0, 6)
+	s = append(s, "&gnoi_sonic.CommitConfirmed{")
+	s = append(s, "TimeoutSeconds: "+fmt.Sprintf("%#v", this.TimeoutSeconds)+",\n")
+	s = append(s, "CommitId: "+fmt.Sprintf("%#v", this.CommitId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CopyConfigRequest_Input) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ConfirmCommitRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ConfirmCommitRequest{")
+	s = append(s, "CommitId: "+fmt.Sprintf("%#v", this.CommitId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConfirmCommitResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Overwrite {
-		n += 2
+	s := make([]string, 0, 4)
+	s = append(s, "&gnoi_sonic.ConfirmCommitResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CancelCommitRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.CancelCommitRequest{")
+	s = append(s, "CommitId: "+fmt.Sprintf("%#v", this.CommitId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CancelCommitResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 4)
+	s = append(s, "&gnoi_sonic.CancelCommitResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CopyConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ListPendingCommitsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Output != nil {
-		l = m.Output.Size()
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 4)
+	s = append(s, "&gnoi_sonic.ListPendingCommitsRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PendingCommit) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 8)
+	s = append(s, "&gnoi_sonic.PendingCommit{")
+	s = append(s, "CommitId: "+fmt.Sprintf("%#v", this.CommitId)+",\n")
+	s = append(s, "User: "+fmt.Sprintf("%#v", this.User)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageInstallRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ListPendingCommitsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ListPendingCommitsResponse{")
+	if this.Commits != nil {
+		s = append(s, "Commits: "+fmt.Sprintf("%#v", this.Commits)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageInstallRequest_Input) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *CheckpointRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Imagename)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&gnoi_sonic.CheckpointRequest{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckpointResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 6)
+	s = append(s, "&gnoi_sonic.CheckpointResponse{")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "NumEntries: "+fmt.Sprintf("%#v", this.NumEntries)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageInstallResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ListCheckpointsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Output != nil {
-		l = m.Output.Size()
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 4)
+	s = append(s, "&gnoi_sonic.ListCheckpointsRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckpointInfo) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 8)
+	s = append(s, "&gnoi_sonic.CheckpointInfo{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "NumEntries: "+fmt.Sprintf("%#v", this.NumEntries)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageRemoveRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ListCheckpointsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ListCheckpointsResponse{")
+	if this.Checkpoints != nil {
+		s = append(s, "Checkpoints: "+fmt.Sprintf("%#v", this.Checkpoints)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageRemoveRequest_Input) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Imagename)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+func (this *DiffCheckpointRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.DiffCheckpointRequest{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageRemoveResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *CheckpointDiff) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Output != nil {
-		l = m.Output.Size()
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&gnoi_sonic.CheckpointDiff{")
+	s = append(s, "Table: "+fmt.Sprintf("%#v", this.Table)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Change: "+fmt.Sprintf("%#v", this.Change)+",\n")
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageDefaultRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovSonic(uint64(l))
+func (this *CheckpointDiff_Field) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 8)
+	s = append(s, "&gnoi_sonic.CheckpointDiff_Field{")
+	s = append(s, "Field: "+fmt.Sprintf("%#v", this.Field)+",\n")
+	s = append(s, "Change: "+fmt.Sprintf("%#v", this.Change)+",\n")
+	s = append(s, "CheckpointValue: "+fmt.Sprintf("%#v", this.CheckpointValue)+",\n")
+	s = append(s, "CurrentValue: "+fmt.Sprintf("%#v", this.CurrentValue)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageDefaultRequest_Input) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *DiffCheckpointResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Imagename)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.DiffCheckpointResponse{")
+	if this.Entries != nil {
+		s = append(s, "Entries: "+fmt.Sprintf("%#v", this.Entries)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ImageDefaultResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Output != nil {
-		l = m.Output.Size()
-		n += 1 + l + sovSonic(uint64(l))
+func (this *RollbackRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.RollbackRequest{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *JwtToken) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *RollbackResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.AccessToken)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.RollbackResponse{")
+	s = append(s, "NumEntries: "+fmt.Sprintf("%#v", this.NumEntries)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActionRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&gnoi_sonic.ActionRequest{")
+	s = append(s, "Path: "+fmt.Sprintf("%#v", this.Path)+",\n")
+	s = append(s, "JsonInput: "+fmt.Sprintf("%#v", this.JsonInput)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActionResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ExpiresIn != 0 {
-		n += 1 + sovSonic(uint64(m.ExpiresIn))
+	s := make([]string, 0, 5)
+	s = append(s, "&gnoi_sonic.ActionResponse{")
+	s = append(s, "JsonOutput: "+fmt.Sprintf("%#v", this.JsonOutput)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringSonic(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
 	}
-	return n
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
 
-func (m *AuthenticateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovSonic(uint64(l))
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SonicServiceClient is the client API for SonicService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SonicServiceClient interface {
+	ShowTechsupport(ctx context.Context, in *TechsupportRequest, opts ...grpc.CallOption) (*TechsupportResponse, error)
+	Sum(ctx context.Context, in *SumRequest, opts ...grpc.CallOption) (*SumResponse, error)
+	CopyConfig(ctx context.Context, in *CopyConfigRequest, opts ...grpc.CallOption) (*CopyConfigResponse, error)
+	ImageInstall(ctx context.Context, in *ImageInstallRequest, opts ...grpc.CallOption) (*ImageInstallResponse, error)
+	ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error)
+	ImageDefault(ctx context.Context, in *ImageDefaultRequest, opts ...grpc.CallOption) (*ImageDefaultResponse, error)
+	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error)
+	ClearNeighbors(ctx context.Context, in *ClearNeighborsRequest, opts ...grpc.CallOption) (*ClearNeighborsResponse, error)
+	SaveCounterBaseline(ctx context.Context, in *SaveCounterBaselineRequest, opts ...grpc.CallOption) (*SaveCounterBaselineResponse, error)
+	DeleteCounterBaseline(ctx context.Context, in *DeleteCounterBaselineRequest, opts ...grpc.CallOption) (*DeleteCounterBaselineResponse, error)
+	ConfirmCommit(ctx context.Context, in *ConfirmCommitRequest, opts ...grpc.CallOption) (*ConfirmCommitResponse, error)
+	CancelCommit(ctx context.Context, in *CancelCommitRequest, opts ...grpc.CallOption) (*CancelCommitResponse, error)
+	ListPendingCommits(ctx context.Context, in *ListPendingCommitsRequest, opts ...grpc.CallOption) (*ListPendingCommitsResponse, error)
+	Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error)
+	ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, opts ...grpc.CallOption) (*ListCheckpointsResponse, error)
+	DiffCheckpoint(ctx context.Context, in *DiffCheckpointRequest, opts ...grpc.CallOption) (*DiffCheckpointResponse, error)
+	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error)
+	Action(ctx context.Context, in *ActionRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+}
+
+type sonicServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSonicServiceClient(cc *grpc.ClientConn) SonicServiceClient {
+	return &sonicServiceClient{cc}
+}
+
+func (c *sonicServiceClient) ShowTechsupport(ctx context.Context, in *TechsupportRequest, opts ...grpc.CallOption) (*TechsupportResponse, error) {
+	out := new(TechsupportResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/ShowTechsupport", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *AuthenticateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *sonicServiceClient) Sum(ctx context.Context, in *SumRequest, opts ...grpc.CallOption) (*SumResponse, error) {
+	out := new(SumResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/Sum", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Token != nil {
-		l = m.Token.Size()
-		n += 1 + l + sovSonic(uint64(l))
+	return out, nil
+}
+
+func (c *sonicServiceClient) CopyConfig(ctx context.Context, in *CopyConfigRequest, opts ...grpc.CallOption) (*CopyConfigResponse, error) {
+	out := new(CopyConfigResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/CopyConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *RefreshRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *sonicServiceClient) ImageInstall(ctx context.Context, in *ImageInstallRequest, opts ...grpc.CallOption) (*ImageInstallResponse, error) {
+	out := new(ImageInstallResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/ImageInstall", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *RefreshResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *sonicServiceClient) ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error) {
+	out := new(ImageRemoveResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/ImageRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Token != nil {
-		l = m.Token.Size()
-		n += 1 + l + sovSonic(uint64(l))
+	return out, nil
+}
+
+func (c *sonicServiceClient) ImageDefault(ctx context.Context, in *ImageDefaultRequest, opts ...grpc.CallOption) (*ImageDefaultResponse, error) {
+	out := new(ImageDefaultResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/ImageDefault", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func sovSonic(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (c *sonicServiceClient) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
+	out := new(AuthenticateResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/Authenticate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func sozSonic(x uint64) (n int) {
-	return sovSonic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (c *sonicServiceClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error) {
+	out := new(RefreshResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/Refresh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (this *SonicOutput) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *sonicServiceClient) ClearNeighbors(ctx context.Context, in *ClearNeighborsRequest, opts ...grpc.CallOption) (*ClearNeighborsResponse, error) {
+	out := new(ClearNeighborsResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/ClearNeighbors", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&SonicOutput{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`StatusDetail:` + fmt.Sprintf("%v", this.StatusDetail) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *TechsupportRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *sonicServiceClient) SaveCounterBaseline(ctx context.Context, in *SaveCounterBaselineRequest, opts ...grpc.CallOption) (*SaveCounterBaselineResponse, error) {
+	out := new(SaveCounterBaselineResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/SaveCounterBaseline", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&TechsupportRequest{`,
-		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "TechsupportRequest_Input", "TechsupportRequest_Input", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *TechsupportRequest_Input) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *sonicServiceClient) DeleteCounterBaseline(ctx context.Context, in *DeleteCounterBaselineRequest, opts ...grpc.CallOption) (*DeleteCounterBaselineResponse, error) {
+	out := new(DeleteCounterBaselineResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/DeleteCounterBaseline", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&TechsupportRequest_Input{`,
-		`Date:` + fmt.Sprintf("%v", this.Date) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *TechsupportResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *sonicServiceClient) ConfirmCommit(ctx context.Context, in *ConfirmCommitRequest, opts ...grpc.CallOption) (*ConfirmCommitResponse, error) {
+	out := new(ConfirmCommitResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/ConfirmCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&TechsupportResponse{`,
-		`Output:` + strings.Replace(fmt.Sprintf("%v", this.Output), "TechsupportResponse_Output", "TechsupportResponse_Output", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *TechsupportResponse_Output) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *sonicServiceClient) CancelCommit(ctx context.Context, in *CancelCommitRequest, opts ...grpc.CallOption) (*CancelCommitResponse, error) {
+	out := new(CancelCommitResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/CancelCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&TechsupportResponse_Output{`,
-		`OutputFilename:` + fmt.Sprintf("%v", this.OutputFilename) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *ClearNeighborsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *sonicServiceClient) ListPendingCommits(ctx context.Context, in *ListPendingCommitsRequest, opts ...grpc.CallOption) (*ListPendingCommitsResponse, error) {
+	out := new(ListPendingCommitsResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/ListPendingCommits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ClearNeighborsRequest{`,
-		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "ClearNeighborsRequest_Input", "ClearNeighborsRequest_Input", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *ClearNeighborsRequest_Input) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *sonicServiceClient) Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error) {
+	out := new(CheckpointResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/Checkpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ClearNeighborsRequest_Input{`,
-		`Force:` + fmt.Sprintf("%v", this.Force) + `,`,
-		`Family:` + fmt.Sprintf("%v", this.Family) + `,`,
-		`Ip:` + fmt.Sprintf("%v", this.Ip) + `,`,
-		`Ifname:` + fmt.Sprintf("%v", this.Ifname) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *ClearNeighborsResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *sonicServiceClient) ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, opts ...grpc.CallOption) (*ListCheckpointsResponse, error) {
+	out := new(ListCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/ListCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ClearNeighborsResponse{`,
-		`Output:` + strings.Replace(fmt.Sprintf("%v", this.Output), "ClearNeighborsResponse_Output", "ClearNeighborsResponse_Output", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *ClearNeighborsResponse_Output) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *sonicServiceClient) DiffCheckpoint(ctx context.Context, in *DiffCheckpointRequest, opts ...grpc.CallOption) (*DiffCheckpointResponse, error) {
+	out := new(DiffCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/DiffCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ClearNeighborsResponse_Output{`,
-		`Response:` + fmt.Sprintf("%v", this.Response) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *SumRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *sonicServiceClient) Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error) {
+	out := new(RollbackResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/Rollback", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&SumRequest{`,
-		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "SumRequest_Input", "SumRequest_Input", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *SumRequest_Input) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *sonicServiceClient) Action(ctx context.Context, in *ActionRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, "/gnoi.sonic.SonicService/Action", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&SumRequest_Input{`,
-		`Left:` + fmt.Sprintf("%v", this.Left) + `,`,
-		`Right:` + fmt.Sprintf("%v", this.Right) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *SumResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&SumResponse{`,
-		`Output:` + strings.Replace(fmt.Sprintf("%v", this.Output), "SumResponse_Output", "SumResponse_Output", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+// SonicServiceServer is the server API for SonicService service.
+type SonicServiceServer interface {
+	ShowTechsupport(context.Context, *TechsupportRequest) (*TechsupportResponse, error)
+	Sum(context.Context, *SumRequest) (*SumResponse, error)
+	CopyConfig(context.Context, *CopyConfigRequest) (*CopyConfigResponse, error)
+	ImageInstall(context.Context, *ImageInstallRequest) (*ImageInstallResponse, error)
+	ImageRemove(context.Context, *ImageRemoveRequest) (*ImageRemoveResponse, error)
+	ImageDefault(context.Context, *ImageDefaultRequest) (*ImageDefaultResponse, error)
+	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
+	Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error)
+	ClearNeighbors(context.Context, *ClearNeighborsRequest) (*ClearNeighborsResponse, error)
+	SaveCounterBaseline(context.Context, *SaveCounterBaselineRequest) (*SaveCounterBaselineResponse, error)
+	DeleteCounterBaseline(context.Context, *DeleteCounterBaselineRequest) (*DeleteCounterBaselineResponse, error)
+	ConfirmCommit(context.Context, *ConfirmCommitRequest) (*ConfirmCommitResponse, error)
+	CancelCommit(context.Context, *CancelCommitRequest) (*CancelCommitResponse, error)
+	ListPendingCommits(context.Context, *ListPendingCommitsRequest) (*ListPendingCommitsResponse, error)
+	Checkpoint(context.Context, *CheckpointRequest) (*CheckpointResponse, error)
+	ListCheckpoints(context.Context, *ListCheckpointsRequest) (*ListCheckpointsResponse, error)
+	DiffCheckpoint(context.Context, *DiffCheckpointRequest) (*DiffCheckpointResponse, error)
+	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
+	Action(context.Context, *ActionRequest) (*ActionResponse, error)
 }
-func (this *SumResponse_Output) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&SumResponse_Output{`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
+
+// UnimplementedSonicServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedSonicServiceServer struct {
 }
-func (this *CopyConfigRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&CopyConfigRequest{`,
-		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "CopyConfigRequest_Input", "CopyConfigRequest_Input", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (*UnimplementedSonicServiceServer) ShowTechsupport(ctx context.Context, req *TechsupportRequest) (*TechsupportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowTechsupport not implemented")
 }
-func (this *CopyConfigRequest_Input) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&CopyConfigRequest_Input{`,
-		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
-		`Overwrite:` + fmt.Sprintf("%v", this.Overwrite) + `,`,
-		`Destination:` + fmt.Sprintf("%v", this.Destination) + `,`,
-		`}`,
-	}, "")
-	return s
+func (*UnimplementedSonicServiceServer) Sum(ctx context.Context, req *SumRequest) (*SumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sum not implemented")
 }
-func (this *CopyConfigResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&CopyConfigResponse{`,
-		`Output:` + strings.Replace(this.Output.String(), "SonicOutput", "SonicOutput", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (*UnimplementedSonicServiceServer) CopyConfig(ctx context.Context, req *CopyConfigRequest) (*CopyConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyConfig not implemented")
 }
-func (this *ImageInstallRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImageInstallRequest{`,
-		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "ImageInstallRequest_Input", "ImageInstallRequest_Input", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (*UnimplementedSonicServiceServer) ImageInstall(ctx context.Context, req *ImageInstallRequest) (*ImageInstallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImageInstall not implemented")
 }
-func (this *ImageInstallRequest_Input) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImageInstallRequest_Input{`,
-		`Imagename:` + fmt.Sprintf("%v", this.Imagename) + `,`,
-		`}`,
-	}, "")
-	return s
+func (*UnimplementedSonicServiceServer) ImageRemove(ctx context.Context, req *ImageRemoveRequest) (*ImageRemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImageRemove not implemented")
 }
-func (this *ImageInstallResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImageInstallResponse{`,
-		`Output:` + strings.Replace(this.Output.String(), "SonicOutput", "SonicOutput", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (*UnimplementedSonicServiceServer) ImageDefault(ctx context.Context, req *ImageDefaultRequest) (*ImageDefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImageDefault not implemented")
 }
-func (this *ImageRemoveRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImageRemoveRequest{`,
-		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "ImageRemoveRequest_Input", "ImageRemoveRequest_Input", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (*UnimplementedSonicServiceServer) Authenticate(ctx context.Context, req *AuthenticateRequest) (*AuthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
 }
-func (this *ImageRemoveRequest_Input) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImageRemoveRequest_Input{`,
-		`Imagename:` + fmt.Sprintf("%v", this.Imagename) + `,`,
-		`}`,
-	}, "")
-	return s
+func (*UnimplementedSonicServiceServer) Refresh(ctx context.Context, req *RefreshRequest) (*RefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
 }
-func (this *ImageRemoveResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImageRemoveResponse{`,
-		`Output:` + strings.Replace(this.Output.String(), "SonicOutput", "SonicOutput", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (*UnimplementedSonicServiceServer) ClearNeighbors(ctx context.Context, req *ClearNeighborsRequest) (*ClearNeighborsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearNeighbors not implemented")
 }
-func (this *ImageDefaultRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImageDefaultRequest{`,
-		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "ImageDefaultRequest_Input", "ImageDefaultRequest_Input", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (*UnimplementedSonicServiceServer) SaveCounterBaseline(ctx context.Context, req *SaveCounterBaselineRequest) (*SaveCounterBaselineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveCounterBaseline not implemented")
 }
-func (this *ImageDefaultRequest_Input) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImageDefaultRequest_Input{`,
-		`Imagename:` + fmt.Sprintf("%v", this.Imagename) + `,`,
-		`}`,
-	}, "")
-	return s
+func (*UnimplementedSonicServiceServer) DeleteCounterBaseline(ctx context.Context, req *DeleteCounterBaselineRequest) (*DeleteCounterBaselineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCounterBaseline not implemented")
 }
-func (this *ImageDefaultResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImageDefaultResponse{`,
-		`Output:` + strings.Replace(this.Output.String(), "SonicOutput", "SonicOutput", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (*UnimplementedSonicServiceServer) ConfirmCommit(ctx context.Context, req *ConfirmCommitRequest) (*ConfirmCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmCommit not implemented")
 }
-func (this *JwtToken) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JwtToken{`,
-		`AccessToken:` + fmt.Sprintf("%v", this.AccessToken) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ExpiresIn:` + fmt.Sprintf("%v", this.ExpiresIn) + `,`,
-		`}`,
-	}, "")
-	return s
+func (*UnimplementedSonicServiceServer) CancelCommit(ctx context.Context, req *CancelCommitRequest) (*CancelCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCommit not implemented")
 }
-func (this *AuthenticateRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&AuthenticateRequest{`,
-		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
-		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
-		`}`,
-	}, "")
-	return s
+func (*UnimplementedSonicServiceServer) ListPendingCommits(ctx context.Context, req *ListPendingCommitsRequest) (*ListPendingCommitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingCommits not implemented")
 }
-func (this *AuthenticateResponse) String() string {
-	if this == nil {
-		return "nil"
+func (*UnimplementedSonicServiceServer) Checkpoint(ctx context.Context, req *CheckpointRequest) (*CheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+func (*UnimplementedSonicServiceServer) ListCheckpoints(ctx context.Context, req *ListCheckpointsRequest) (*ListCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCheckpoints not implemented")
+}
+func (*UnimplementedSonicServiceServer) DiffCheckpoint(ctx context.Context, req *DiffCheckpointRequest) (*DiffCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffCheckpoint not implemented")
+}
+func (*UnimplementedSonicServiceServer) Rollback(ctx context.Context, req *RollbackRequest) (*RollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (*UnimplementedSonicServiceServer) Action(ctx context.Context, req *ActionRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Action not implemented")
+}
+
+func RegisterSonicServiceServer(s *grpc.Server, srv SonicServiceServer) {
+	s.RegisterService(&_SonicService_serviceDesc, srv)
+}
+
+func _SonicService_ShowTechsupport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TechsupportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&AuthenticateResponse{`,
-		`Token:` + strings.Replace(this.Token.String(), "JwtToken", "JwtToken", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if interceptor == nil {
+		return srv.(SonicServiceServer).ShowTechsupport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/ShowTechsupport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).ShowTechsupport(ctx, req.(*TechsupportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (this *RefreshRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func _SonicService_Sum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&RefreshRequest{`,
-		`}`,
-	}, "")
-	return s
+	if interceptor == nil {
+		return srv.(SonicServiceServer).Sum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/Sum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).Sum(ctx, req.(*SumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (this *RefreshResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func _SonicService_CopyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).CopyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/CopyConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).CopyConfig(ctx, req.(*CopyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_ImageInstall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageInstallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).ImageInstall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/ImageInstall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).ImageInstall(ctx, req.(*ImageInstallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_ImageRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).ImageRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/ImageRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).ImageRemove(ctx, req.(*ImageRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_ImageDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageDefaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).ImageDefault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/ImageDefault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).ImageDefault(ctx, req.(*ImageDefaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).Authenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/Authenticate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).Authenticate(ctx, req.(*AuthenticateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/Refresh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).Refresh(ctx, req.(*RefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_ClearNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearNeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).ClearNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/ClearNeighbors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).ClearNeighbors(ctx, req.(*ClearNeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_SaveCounterBaseline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveCounterBaselineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).SaveCounterBaseline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/SaveCounterBaseline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).SaveCounterBaseline(ctx, req.(*SaveCounterBaselineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_DeleteCounterBaseline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCounterBaselineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).DeleteCounterBaseline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/DeleteCounterBaseline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).DeleteCounterBaseline(ctx, req.(*DeleteCounterBaselineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_ConfirmCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).ConfirmCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/ConfirmCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).ConfirmCommit(ctx, req.(*ConfirmCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_CancelCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).CancelCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/CancelCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).CancelCommit(ctx, req.(*CancelCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_ListPendingCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingCommitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).ListPendingCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/ListPendingCommits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).ListPendingCommits(ctx, req.(*ListPendingCommitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/Checkpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).Checkpoint(ctx, req.(*CheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_ListCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).ListCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/ListCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).ListCheckpoints(ctx, req.(*ListCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_DiffCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).DiffCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/DiffCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).DiffCheckpoint(ctx, req.(*DiffCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/Rollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).Rollback(ctx, req.(*RollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SonicService_Action_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SonicServiceServer).Action(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnoi.sonic.SonicService/Action",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SonicServiceServer).Action(ctx, req.(*ActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SonicService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gnoi.sonic.SonicService",
+	HandlerType: (*SonicServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ShowTechsupport",
+			Handler:    _SonicService_ShowTechsupport_Handler,
+		},
+		{
+			MethodName: "Sum",
+			Handler:    _SonicService_Sum_Handler,
+		},
+		{
+			MethodName: "CopyConfig",
+			Handler:    _SonicService_CopyConfig_Handler,
+		},
+		{
+			MethodName: "ImageInstall",
+			Handler:    _SonicService_ImageInstall_Handler,
+		},
+		{
+			MethodName: "ImageRemove",
+			Handler:    _SonicService_ImageRemove_Handler,
+		},
+		{
+			MethodName: "ImageDefault",
+			Handler:    _SonicService_ImageDefault_Handler,
+		},
+		{
+			MethodName: "Authenticate",
+			Handler:    _SonicService_Authenticate_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _SonicService_Refresh_Handler,
+		},
+		{
+			MethodName: "ClearNeighbors",
+			Handler:    _SonicService_ClearNeighbors_Handler,
+		},
+		{
+			MethodName: "SaveCounterBaseline",
+			Handler:    _SonicService_SaveCounterBaseline_Handler,
+		},
+		{
+			MethodName: "DeleteCounterBaseline",
+			Handler:    _SonicService_DeleteCounterBaseline_Handler,
+		},
+		{
+			MethodName: "ConfirmCommit",
+			Handler:    _SonicService_ConfirmCommit_Handler,
+		},
+		{
+			MethodName: "CancelCommit",
+			Handler:    _SonicService_CancelCommit_Handler,
+		},
+		{
+			MethodName: "ListPendingCommits",
+			Handler:    _SonicService_ListPendingCommits_Handler,
+		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _SonicService_Checkpoint_Handler,
+		},
+		{
+			MethodName: "ListCheckpoints",
+			Handler:    _SonicService_ListCheckpoints_Handler,
+		},
+		{
+			MethodName: "DiffCheckpoint",
+			Handler:    _SonicService_DiffCheckpoint_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _SonicService_Rollback_Handler,
+		},
+		{
+			MethodName: "Action",
+			Handler:    _SonicService_Action_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sonic.proto",
+}
+
+func (m *SonicOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SonicOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SonicOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StatusDetail) > 0 {
+		i -= len(m.StatusDetail)
+		copy(dAtA[i:], m.StatusDetail)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.StatusDetail)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TechsupportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TechsupportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TechsupportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TechsupportRequest_Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TechsupportRequest_Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TechsupportRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TechsupportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TechsupportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TechsupportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Output != nil {
+		{
+			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TechsupportResponse_Output) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TechsupportResponse_Output) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TechsupportResponse_Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutputFilename) > 0 {
+		i -= len(m.OutputFilename)
+		copy(dAtA[i:], m.OutputFilename)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.OutputFilename)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClearNeighborsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearNeighborsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearNeighborsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClearNeighborsRequest_Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearNeighborsRequest_Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearNeighborsRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ifname) > 0 {
+		i -= len(m.Ifname)
+		copy(dAtA[i:], m.Ifname)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Ifname)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ip) > 0 {
+		i -= len(m.Ip)
+		copy(dAtA[i:], m.Ip)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Ip)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Family) > 0 {
+		i -= len(m.Family)
+		copy(dAtA[i:], m.Family)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Family)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClearNeighborsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearNeighborsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearNeighborsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Output != nil {
+		{
+			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClearNeighborsResponse_Output) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearNeighborsResponse_Output) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearNeighborsResponse_Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SumRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SumRequest_Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SumRequest_Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SumRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Right != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.Right))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Left != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.Left))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Output != nil {
+		{
+			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SumResponse_Output) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SumResponse_Output) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SumResponse_Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CopyConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CopyConfigRequest_Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyConfigRequest_Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyConfigRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Overwrite {
+		i--
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CopyConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Output != nil {
+		{
+			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageInstallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageInstallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageInstallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageInstallRequest_Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageInstallRequest_Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageInstallRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Imagename) > 0 {
+		i -= len(m.Imagename)
+		copy(dAtA[i:], m.Imagename)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Imagename)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageInstallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageInstallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageInstallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Output != nil {
+		{
+			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageRemoveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageRemoveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageRemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageRemoveRequest_Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageRemoveRequest_Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageRemoveRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Imagename) > 0 {
+		i -= len(m.Imagename)
+		copy(dAtA[i:], m.Imagename)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Imagename)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageRemoveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageRemoveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageRemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Output != nil {
+		{
+			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageDefaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageDefaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageDefaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageDefaultRequest_Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageDefaultRequest_Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageDefaultRequest_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Imagename) > 0 {
+		i -= len(m.Imagename)
+		copy(dAtA[i:], m.Imagename)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Imagename)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageDefaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageDefaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageDefaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Output != nil {
+		{
+			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JwtToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JwtToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JwtToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresIn != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.ExpiresIn))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AccessToken) > 0 {
+		i -= len(m.AccessToken)
+		copy(dAtA[i:], m.AccessToken)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.AccessToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSonic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SaveCounterBaselineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SaveCounterBaselineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SaveCounterBaselineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintSonic(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SaveCounterBaselineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SaveCounterBaselineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SaveCounterBaselineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumKeys != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.NumKeys))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteCounterBaselineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteCounterBaselineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteCounterBaselineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteCounterBaselineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteCounterBaselineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteCounterBaselineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitConfirmed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitConfirmed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitConfirmed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommitId) > 0 {
+		i -= len(m.CommitId)
+		copy(dAtA[i:], m.CommitId)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.CommitId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TimeoutSeconds != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfirmCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommitId) > 0 {
+		i -= len(m.CommitId)
+		copy(dAtA[i:], m.CommitId)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.CommitId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfirmCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommitId) > 0 {
+		i -= len(m.CommitId)
+		copy(dAtA[i:], m.CommitId)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.CommitId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPendingCommitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPendingCommitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPendingCommitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CommitId) > 0 {
+		i -= len(m.CommitId)
+		copy(dAtA[i:], m.CommitId)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.CommitId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPendingCommitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPendingCommitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPendingCommitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commits) > 0 {
+		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSonic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEntries != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.NumEntries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListCheckpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEntries != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.NumEntries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListCheckpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSonic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DiffCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiffCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSonic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Change != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.Change))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointDiff_Field) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointDiff_Field) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointDiff_Field) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentValue) > 0 {
+		i -= len(m.CurrentValue)
+		copy(dAtA[i:], m.CurrentValue)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.CurrentValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CheckpointValue) > 0 {
+		i -= len(m.CheckpointValue)
+		copy(dAtA[i:], m.CheckpointValue)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.CheckpointValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Change != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.Change))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DiffCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiffCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSonic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEntries != 0 {
+		i = encodeVarintSonic(dAtA, i, uint64(m.NumEntries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JsonInput) > 0 {
+		i -= len(m.JsonInput)
+		copy(dAtA[i:], m.JsonInput)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.JsonInput)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JsonOutput) > 0 {
+		i -= len(m.JsonOutput)
+		copy(dAtA[i:], m.JsonOutput)
+		i = encodeVarintSonic(dAtA, i, uint64(len(m.JsonOutput)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSonic(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSonic(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SonicOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovSonic(uint64(m.Status))
+	}
+	l = len(m.StatusDetail)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *TechsupportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *TechsupportRequest_Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *TechsupportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		l = m.Output.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *TechsupportResponse_Output) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OutputFilename)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ClearNeighborsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ClearNeighborsRequest_Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Force {
+		n += 2
+	}
+	l = len(m.Family)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	l = len(m.Ip)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	l = len(m.Ifname)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ClearNeighborsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		l = m.Output.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ClearNeighborsResponse_Output) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *SumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *SumRequest_Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Left != 0 {
+		n += 1 + sovSonic(uint64(m.Left))
+	}
+	if m.Right != 0 {
+		n += 1 + sovSonic(uint64(m.Right))
+	}
+	return n
+}
+
+func (m *SumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		l = m.Output.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *SumResponse_Output) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovSonic(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *CopyConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *CopyConfigRequest_Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	if m.Overwrite {
+		n += 2
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *CopyConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		l = m.Output.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageInstallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageInstallRequest_Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Imagename)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageInstallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		l = m.Output.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageRemoveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageRemoveRequest_Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Imagename)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageRemoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		l = m.Output.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageDefaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageDefaultRequest_Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Imagename)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageDefaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		l = m.Output.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *JwtToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccessToken)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	if m.ExpiresIn != 0 {
+		n += 1 + sovSonic(uint64(m.ExpiresIn))
+	}
+	return n
+}
+
+func (m *AuthenticateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthenticateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RefreshResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *SaveCounterBaselineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovSonic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SaveCounterBaselineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumKeys != 0 {
+		n += 1 + sovSonic(uint64(m.NumKeys))
+	}
+	return n
+}
+
+func (m *DeleteCounterBaselineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteCounterBaselineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CommitConfirmed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeoutSeconds != 0 {
+		n += 1 + sovSonic(uint64(m.TimeoutSeconds))
+	}
+	l = len(m.CommitId)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfirmCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CommitId)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfirmCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CancelCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CommitId)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListPendingCommitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PendingCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CommitId)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovSonic(uint64(m.Timestamp))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovSonic(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *ListPendingCommitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovSonic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovSonic(uint64(m.Timestamp))
+	}
+	if m.NumEntries != 0 {
+		n += 1 + sovSonic(uint64(m.NumEntries))
+	}
+	return n
+}
+
+func (m *ListCheckpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CheckpointInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovSonic(uint64(m.Timestamp))
+	}
+	if m.NumEntries != 0 {
+		n += 1 + sovSonic(uint64(m.NumEntries))
+	}
+	return n
+}
+
+func (m *ListCheckpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovSonic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DiffCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	if m.Change != 0 {
+		n += 1 + sovSonic(uint64(m.Change))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovSonic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointDiff_Field) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	if m.Change != 0 {
+		n += 1 + sovSonic(uint64(m.Change))
+	}
+	l = len(m.CheckpointValue)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	l = len(m.CurrentValue)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *DiffCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovSonic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RollbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *RollbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumEntries != 0 {
+		n += 1 + sovSonic(uint64(m.NumEntries))
+	}
+	return n
+}
+
+func (m *ActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	l = len(m.JsonInput)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func (m *ActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JsonOutput)
+	if l > 0 {
+		n += 1 + l + sovSonic(uint64(l))
+	}
+	return n
+}
+
+func sovSonic(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSonic(x uint64) (n int) {
+	return sovSonic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SonicOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SonicOutput{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`StatusDetail:` + fmt.Sprintf("%v", this.StatusDetail) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TechsupportRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TechsupportRequest{`,
+		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "TechsupportRequest_Input", "TechsupportRequest_Input", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TechsupportRequest_Input) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TechsupportRequest_Input{`,
+		`Date:` + fmt.Sprintf("%v", this.Date) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TechsupportResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TechsupportResponse{`,
+		`Output:` + strings.Replace(fmt.Sprintf("%v", this.Output), "TechsupportResponse_Output", "TechsupportResponse_Output", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TechsupportResponse_Output) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TechsupportResponse_Output{`,
+		`OutputFilename:` + fmt.Sprintf("%v", this.OutputFilename) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClearNeighborsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClearNeighborsRequest{`,
+		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "ClearNeighborsRequest_Input", "ClearNeighborsRequest_Input", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClearNeighborsRequest_Input) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClearNeighborsRequest_Input{`,
+		`Force:` + fmt.Sprintf("%v", this.Force) + `,`,
+		`Family:` + fmt.Sprintf("%v", this.Family) + `,`,
+		`Ip:` + fmt.Sprintf("%v", this.Ip) + `,`,
+		`Ifname:` + fmt.Sprintf("%v", this.Ifname) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClearNeighborsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClearNeighborsResponse{`,
+		`Output:` + strings.Replace(fmt.Sprintf("%v", this.Output), "ClearNeighborsResponse_Output", "ClearNeighborsResponse_Output", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClearNeighborsResponse_Output) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClearNeighborsResponse_Output{`,
+		`Response:` + fmt.Sprintf("%v", this.Response) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SumRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SumRequest{`,
+		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "SumRequest_Input", "SumRequest_Input", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SumRequest_Input) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SumRequest_Input{`,
+		`Left:` + fmt.Sprintf("%v", this.Left) + `,`,
+		`Right:` + fmt.Sprintf("%v", this.Right) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SumResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SumResponse{`,
+		`Output:` + strings.Replace(fmt.Sprintf("%v", this.Output), "SumResponse_Output", "SumResponse_Output", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SumResponse_Output) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SumResponse_Output{`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CopyConfigRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CopyConfigRequest{`,
+		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "CopyConfigRequest_Input", "CopyConfigRequest_Input", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CopyConfigRequest_Input) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CopyConfigRequest_Input{`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Overwrite:` + fmt.Sprintf("%v", this.Overwrite) + `,`,
+		`Destination:` + fmt.Sprintf("%v", this.Destination) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CopyConfigResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CopyConfigResponse{`,
+		`Output:` + strings.Replace(this.Output.String(), "SonicOutput", "SonicOutput", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageInstallRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageInstallRequest{`,
+		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "ImageInstallRequest_Input", "ImageInstallRequest_Input", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageInstallRequest_Input) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageInstallRequest_Input{`,
+		`Imagename:` + fmt.Sprintf("%v", this.Imagename) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageInstallResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageInstallResponse{`,
+		`Output:` + strings.Replace(this.Output.String(), "SonicOutput", "SonicOutput", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageRemoveRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageRemoveRequest{`,
+		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "ImageRemoveRequest_Input", "ImageRemoveRequest_Input", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageRemoveRequest_Input) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageRemoveRequest_Input{`,
+		`Imagename:` + fmt.Sprintf("%v", this.Imagename) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageRemoveResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageRemoveResponse{`,
+		`Output:` + strings.Replace(this.Output.String(), "SonicOutput", "SonicOutput", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageDefaultRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageDefaultRequest{`,
+		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "ImageDefaultRequest_Input", "ImageDefaultRequest_Input", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageDefaultRequest_Input) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageDefaultRequest_Input{`,
+		`Imagename:` + fmt.Sprintf("%v", this.Imagename) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageDefaultResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageDefaultResponse{`,
+		`Output:` + strings.Replace(this.Output.String(), "SonicOutput", "SonicOutput", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JwtToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JwtToken{`,
+		`AccessToken:` + fmt.Sprintf("%v", this.AccessToken) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ExpiresIn:` + fmt.Sprintf("%v", this.ExpiresIn) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthenticateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthenticateRequest{`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthenticateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthenticateResponse{`,
+		`Token:` + strings.Replace(this.Token.String(), "JwtToken", "JwtToken", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshResponse{`,
+		`Token:` + strings.Replace(this.Token.String(), "JwtToken", "JwtToken", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SaveCounterBaselineRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SaveCounterBaselineRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Paths:` + fmt.Sprintf("%v", this.Paths) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SaveCounterBaselineResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SaveCounterBaselineResponse{`,
+		`NumKeys:` + fmt.Sprintf("%v", this.NumKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteCounterBaselineRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteCounterBaselineRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteCounterBaselineResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteCounterBaselineResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CommitConfirmed) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CommitConfirmed{`,
+		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
+		`CommitId:` + fmt.Sprintf("%v", this.CommitId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfirmCommitRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfirmCommitRequest{`,
+		`CommitId:` + fmt.Sprintf("%v", this.CommitId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfirmCommitResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfirmCommitResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancelCommitRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancelCommitRequest{`,
+		`CommitId:` + fmt.Sprintf("%v", this.CommitId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancelCommitResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancelCommitResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListPendingCommitsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListPendingCommitsRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingCommit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingCommit{`,
+		`CommitId:` + fmt.Sprintf("%v", this.CommitId) + `,`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListPendingCommitsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCommits := "[]*PendingCommit{"
+	for _, f := range this.Commits {
+		repeatedStringForCommits += strings.Replace(f.String(), "PendingCommit", "PendingCommit", 1) + ","
+	}
+	repeatedStringForCommits += "}"
+	s := strings.Join([]string{`&ListPendingCommitsResponse{`,
+		`Commits:` + repeatedStringForCommits + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckpointRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckpointRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckpointResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckpointResponse{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`NumEntries:` + fmt.Sprintf("%v", this.NumEntries) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListCheckpointsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListCheckpointsRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckpointInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckpointInfo{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`NumEntries:` + fmt.Sprintf("%v", this.NumEntries) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListCheckpointsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCheckpoints := "[]*CheckpointInfo{"
+	for _, f := range this.Checkpoints {
+		repeatedStringForCheckpoints += strings.Replace(f.String(), "CheckpointInfo", "CheckpointInfo", 1) + ","
+	}
+	repeatedStringForCheckpoints += "}"
+	s := strings.Join([]string{`&ListCheckpointsResponse{`,
+		`Checkpoints:` + repeatedStringForCheckpoints + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiffCheckpointRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DiffCheckpointRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckpointDiff) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFields := "[]*CheckpointDiff_Field{"
+	for _, f := range this.Fields {
+		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "CheckpointDiff_Field", "CheckpointDiff_Field", 1) + ","
+	}
+	repeatedStringForFields += "}"
+	s := strings.Join([]string{`&CheckpointDiff{`,
+		`Table:` + fmt.Sprintf("%v", this.Table) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Change:` + fmt.Sprintf("%v", this.Change) + `,`,
+		`Fields:` + repeatedStringForFields + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckpointDiff_Field) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckpointDiff_Field{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Change:` + fmt.Sprintf("%v", this.Change) + `,`,
+		`CheckpointValue:` + fmt.Sprintf("%v", this.CheckpointValue) + `,`,
+		`CurrentValue:` + fmt.Sprintf("%v", this.CurrentValue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiffCheckpointResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEntries := "[]*CheckpointDiff{"
+	for _, f := range this.Entries {
+		repeatedStringForEntries += strings.Replace(f.String(), "CheckpointDiff", "CheckpointDiff", 1) + ","
+	}
+	repeatedStringForEntries += "}"
+	s := strings.Join([]string{`&DiffCheckpointResponse{`,
+		`Entries:` + repeatedStringForEntries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RollbackRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RollbackRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RollbackResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RollbackResponse{`,
+		`NumEntries:` + fmt.Sprintf("%v", this.NumEntries) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActionRequest{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`JsonInput:` + fmt.Sprintf("%v", this.JsonInput) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActionResponse{`,
+		`JsonOutput:` + fmt.Sprintf("%v", this.JsonOutput) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringSonic(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SonicOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SonicOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SonicOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusDetail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusDetail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TechsupportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TechsupportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TechsupportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &TechsupportRequest_Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TechsupportRequest_Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TechsupportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TechsupportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TechsupportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Output == nil {
+				m.Output = &TechsupportResponse_Output{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TechsupportResponse_Output) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputFilename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputFilename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearNeighborsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearNeighborsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearNeighborsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &ClearNeighborsRequest_Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearNeighborsRequest_Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Family", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Family = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ip = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ifname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ifname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearNeighborsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearNeighborsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearNeighborsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Output == nil {
+				m.Output = &ClearNeighborsResponse_Output{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearNeighborsResponse_Output) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Response = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &SumRequest_Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SumRequest_Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
+			}
+			m.Left = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Left |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+			}
+			m.Right = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Right |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Output == nil {
+				m.Output = &SumResponse_Output{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SumResponse_Output) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CopyConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CopyConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CopyConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &CopyConfigRequest_Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CopyConfigRequest_Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CopyConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CopyConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CopyConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Output == nil {
+				m.Output = &SonicOutput{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageInstallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageInstallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageInstallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &ImageInstallRequest_Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageInstallRequest_Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imagename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Imagename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageInstallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageInstallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageInstallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Output == nil {
+				m.Output = &SonicOutput{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageRemoveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageRemoveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &ImageRemoveRequest_Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageRemoveRequest_Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imagename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Imagename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageRemoveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageRemoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Output == nil {
+				m.Output = &SonicOutput{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageDefaultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageDefaultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageDefaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &ImageDefaultRequest_Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&RefreshResponse{`,
-		`Token:` + strings.Replace(this.Token.String(), "JwtToken", "JwtToken", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringSonic(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *SonicOutput) Unmarshal(dAtA []byte) error {
+func (m *ImageDefaultRequest_Input) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4782,17 +11057,17 @@ func (m *SonicOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SonicOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SonicOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imagename", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -4802,16 +11077,82 @@ func (m *SonicOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Imagename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageDefaultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageDefaultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageDefaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusDetail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -4821,23 +11162,27 @@ func (m *SonicOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSonic
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSonic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StatusDetail = string(dAtA[iNdEx:postIndex])
+			if m.Output == nil {
+				m.Output = &SonicOutput{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4863,7 +11208,7 @@ func (m *SonicOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TechsupportRequest) Unmarshal(dAtA []byte) error {
+func (m *JwtToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4886,17 +11231,17 @@ func (m *TechsupportRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TechsupportRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JwtToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TechsupportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JwtToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -4906,28 +11251,75 @@ func (m *TechsupportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSonic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSonic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &TechsupportRequest_Input{}
+			m.AccessToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+			}
+			m.ExpiresIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresIn |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -4952,7 +11344,7 @@ func (m *TechsupportRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TechsupportRequest_Input) Unmarshal(dAtA []byte) error {
+func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4975,15 +11367,15 @@ func (m *TechsupportRequest_Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthenticateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthenticateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5011,7 +11403,39 @@ func (m *TechsupportRequest_Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Date = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5037,7 +11461,7 @@ func (m *TechsupportRequest_Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TechsupportResponse) Unmarshal(dAtA []byte) error {
+func (m *AuthenticateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5060,15 +11484,15 @@ func (m *TechsupportResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TechsupportResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthenticateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TechsupportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5095,10 +11519,10 @@ func (m *TechsupportResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Output == nil {
-				m.Output = &TechsupportResponse_Output{}
+			if m.Token == nil {
+				m.Token = &JwtToken{}
 			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5126,7 +11550,7 @@ func (m *TechsupportResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TechsupportResponse_Output) Unmarshal(dAtA []byte) error {
+func (m *RefreshRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5149,44 +11573,12 @@ func (m *TechsupportResponse_Output) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputFilename", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSonic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSonic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSonic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutputFilename = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -5211,7 +11603,7 @@ func (m *TechsupportResponse_Output) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClearNeighborsRequest) Unmarshal(dAtA []byte) error {
+func (m *RefreshResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5234,15 +11626,15 @@ func (m *ClearNeighborsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClearNeighborsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearNeighborsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5269,10 +11661,10 @@ func (m *ClearNeighborsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &ClearNeighborsRequest_Input{}
+			if m.Token == nil {
+				m.Token = &JwtToken{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5300,7 +11692,7 @@ func (m *ClearNeighborsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClearNeighborsRequest_Input) Unmarshal(dAtA []byte) error {
+func (m *SaveCounterBaselineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5323,17 +11715,17 @@ func (m *ClearNeighborsRequest_Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: SaveCounterBaselineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SaveCounterBaselineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -5343,15 +11735,27 @@ func (m *ClearNeighborsRequest_Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Family", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5379,11 +11783,11 @@ func (m *ClearNeighborsRequest_Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Family = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5411,13 +11815,66 @@ func (m *ClearNeighborsRequest_Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ip = string(dAtA[iNdEx:postIndex])
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ifname", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SaveCounterBaselineResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SaveCounterBaselineResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SaveCounterBaselineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumKeys", wireType)
+			}
+			m.NumKeys = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -5427,24 +11884,11 @@ func (m *ClearNeighborsRequest_Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumKeys |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSonic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSonic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ifname = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -5469,7 +11913,7 @@ func (m *ClearNeighborsRequest_Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClearNeighborsResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteCounterBaselineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5492,17 +11936,17 @@ func (m *ClearNeighborsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClearNeighborsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteCounterBaselineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearNeighborsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteCounterBaselineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -5512,28 +11956,77 @@ func (m *ClearNeighborsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSonic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSonic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Output == nil {
-				m.Output = &ClearNeighborsResponse_Output{}
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteCounterBaselineResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteCounterBaselineResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteCounterBaselineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -5558,7 +12051,7 @@ func (m *ClearNeighborsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClearNeighborsResponse_Output) Unmarshal(dAtA []byte) error {
+func (m *CommitConfirmed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5581,15 +12074,34 @@ func (m *ClearNeighborsResponse_Output) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitConfirmed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitConfirmed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5617,7 +12129,7 @@ func (m *ClearNeighborsResponse_Output) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Response = string(dAtA[iNdEx:postIndex])
+			m.CommitId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5643,7 +12155,7 @@ func (m *ClearNeighborsResponse_Output) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SumRequest) Unmarshal(dAtA []byte) error {
+func (m *ConfirmCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5666,17 +12178,17 @@ func (m *SumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfirmCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfirmCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -5686,27 +12198,23 @@ func (m *SumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSonic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSonic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &SumRequest_Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CommitId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5732,7 +12240,7 @@ func (m *SumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SumRequest_Input) Unmarshal(dAtA []byte) error {
+func (m *ConfirmCommitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5755,50 +12263,12 @@ func (m *SumRequest_Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfirmCommitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfirmCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
-			}
-			m.Left = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSonic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Left |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
-			}
-			m.Right = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSonic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Right |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -5823,7 +12293,7 @@ func (m *SumRequest_Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SumResponse) Unmarshal(dAtA []byte) error {
+func (m *CancelCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5846,17 +12316,17 @@ func (m *SumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -5866,27 +12336,23 @@ func (m *SumResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSonic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSonic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Output == nil {
-				m.Output = &SumResponse_Output{}
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CommitId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5912,7 +12378,7 @@ func (m *SumResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SumResponse_Output) Unmarshal(dAtA []byte) error {
+func (m *CancelCommitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5935,31 +12401,12 @@ func (m *SumResponse_Output) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelCommitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSonic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -5984,7 +12431,7 @@ func (m *SumResponse_Output) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CopyConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *ListPendingCommitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6007,48 +12454,12 @@ func (m *CopyConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CopyConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPendingCommitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CopyConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPendingCommitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSonic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSonic
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSonic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Input == nil {
-				m.Input = &CopyConfigRequest_Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -6073,7 +12484,7 @@ func (m *CopyConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CopyConfigRequest_Input) Unmarshal(dAtA []byte) error {
+func (m *PendingCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6096,15 +12507,15 @@ func (m *CopyConfigRequest_Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6132,13 +12543,13 @@ func (m *CopyConfigRequest_Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.CommitId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -6148,17 +12559,29 @@ func (m *CopyConfigRequest_Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -6168,24 +12591,30 @@ func (m *CopyConfigRequest_Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSonic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSonic
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -6210,7 +12639,7 @@ func (m *CopyConfigRequest_Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CopyConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *ListPendingCommitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6233,15 +12662,15 @@ func (m *CopyConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CopyConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPendingCommitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CopyConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPendingCommitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6268,10 +12697,8 @@ func (m *CopyConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Output == nil {
-				m.Output = &SonicOutput{}
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, &PendingCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6299,7 +12726,7 @@ func (m *CopyConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageInstallRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckpointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6322,17 +12749,17 @@ func (m *ImageInstallRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageInstallRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageInstallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -6342,84 +12769,27 @@ func (m *ImageInstallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSonic
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSonic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Input == nil {
-				m.Input = &ImageInstallRequest_Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSonic(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSonic
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthSonic
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ImageInstallRequest_Input) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSonic
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Imagename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6447,7 +12817,7 @@ func (m *ImageInstallRequest_Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Imagename = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6473,7 +12843,7 @@ func (m *ImageInstallRequest_Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageInstallResponse) Unmarshal(dAtA []byte) error {
+func (m *CheckpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6496,17 +12866,17 @@ func (m *ImageInstallResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageInstallResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageInstallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var msglen int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -6516,28 +12886,30 @@ func (m *ImageInstallResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSonic
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSonic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Output == nil {
-				m.Output = &SonicOutput{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEntries", wireType)
 			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NumEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -6562,7 +12934,7 @@ func (m *ImageInstallResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageRemoveRequest) Unmarshal(dAtA []byte) error {
+func (m *ListCheckpointsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6585,48 +12957,12 @@ func (m *ImageRemoveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageRemoveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCheckpointsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSonic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSonic
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSonic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Input == nil {
-				m.Input = &ImageRemoveRequest_Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -6651,7 +12987,7 @@ func (m *ImageRemoveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageRemoveRequest_Input) Unmarshal(dAtA []byte) error {
+func (m *CheckpointInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6674,15 +13010,15 @@ func (m *ImageRemoveRequest_Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Imagename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6710,8 +13046,78 @@ func (m *ImageRemoveRequest_Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Imagename = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEntries", wireType)
+			}
+			m.NumEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -6736,7 +13142,7 @@ func (m *ImageRemoveRequest_Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageRemoveResponse) Unmarshal(dAtA []byte) error {
+func (m *ListCheckpointsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6759,15 +13165,15 @@ func (m *ImageRemoveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageRemoveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCheckpointsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6794,10 +13200,8 @@ func (m *ImageRemoveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Output == nil {
-				m.Output = &SonicOutput{}
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Checkpoints = append(m.Checkpoints, &CheckpointInfo{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6825,7 +13229,7 @@ func (m *ImageRemoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageDefaultRequest) Unmarshal(dAtA []byte) error {
+func (m *DiffCheckpointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6848,17 +13252,17 @@ func (m *ImageDefaultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageDefaultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiffCheckpointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageDefaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiffCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -6868,27 +13272,23 @@ func (m *ImageDefaultRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSonic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSonic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &ImageDefaultRequest_Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6914,7 +13314,7 @@ func (m *ImageDefaultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageDefaultRequest_Input) Unmarshal(dAtA []byte) error {
+func (m *CheckpointDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6937,15 +13337,15 @@ func (m *ImageDefaultRequest_Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Imagename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6973,64 +13373,62 @@ func (m *ImageDefaultRequest_Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Imagename = string(dAtA[iNdEx:postIndex])
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSonic(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSonic
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthSonic
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ImageDefaultResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSonic
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Change = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Change |= CheckpointDiff_Change(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ImageDefaultResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageDefaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7057,10 +13455,8 @@ func (m *ImageDefaultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Output == nil {
-				m.Output = &SonicOutput{}
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fields = append(m.Fields, &CheckpointDiff_Field{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7088,7 +13484,7 @@ func (m *ImageDefaultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JwtToken) Unmarshal(dAtA []byte) error {
+func (m *CheckpointDiff_Field) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7111,15 +13507,15 @@ func (m *JwtToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JwtToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: Field: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JwtToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Field: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7147,11 +13543,30 @@ func (m *JwtToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessToken = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			m.Change = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Change |= CheckpointDiff_Change(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7179,13 +13594,13 @@ func (m *JwtToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.CheckpointValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValue", wireType)
 			}
-			m.ExpiresIn = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -7195,11 +13610,24 @@ func (m *JwtToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresIn |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -7224,7 +13652,7 @@ func (m *JwtToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
+func (m *DiffCheckpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7247,17 +13675,17 @@ func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthenticateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiffCheckpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthenticateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiffCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -7267,27 +13695,82 @@ func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSonic
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSonic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, &CheckpointDiff{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSonic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSonic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7315,7 +13798,7 @@ func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7341,7 +13824,7 @@ func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthenticateResponse) Unmarshal(dAtA []byte) error {
+func (m *RollbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7364,17 +13847,17 @@ func (m *AuthenticateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthenticateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEntries", wireType)
 			}
-			var msglen int
+			m.NumEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -7384,28 +13867,11 @@ func (m *AuthenticateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NumEntries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSonic
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSonic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Token == nil {
-				m.Token = &JwtToken{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -7430,7 +13896,7 @@ func (m *AuthenticateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshRequest) Unmarshal(dAtA []byte) error {
+func (m *ActionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7453,12 +13919,76 @@ func (m *RefreshRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonInput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSonic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSonic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSonic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonInput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSonic(dAtA[iNdEx:])
@@ -7483,7 +14013,7 @@ func (m *RefreshRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshResponse) Unmarshal(dAtA []byte) error {
+func (m *ActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7506,17 +14036,17 @@ func (m *RefreshResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonOutput", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSonic
@@ -7526,27 +14056,23 @@ func (m *RefreshResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSonic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSonic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &JwtToken{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JsonOutput = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
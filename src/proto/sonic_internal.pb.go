@@ -53,6 +53,11 @@ type Value struct {
 	SyncResponse bool `protobuf:"varint,5,opt,name=sync_response,json=syncResponse" json:"sync_response,omitempty"`
 	// fatal error happened.
 	Fatal string `protobuf:"bytes,6,opt,name=fatal" json:"fatal,omitempty"`
+	// More updates of the same prefix and timestamp, following the one of
+	// path and val if any.
+	Updates []*gnmi.Update `protobuf:"bytes,7,rep,name=updates" json:"updates,omitempty"`
+	// Paths deleted, at the same timestamp.
+	Deletes []*gnmi.Path `protobuf:"bytes,8,rep,name=deletes" json:"deletes,omitempty"`
 }
 
 func (m *Value) Reset()                    { *m = Value{} }
@@ -102,6 +107,20 @@ func (m *Value) GetFatal() string {
 	return ""
 }
 
+func (m *Value) GetUpdates() []*gnmi.Update {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+func (m *Value) GetDeletes() []*gnmi.Path {
+	if m != nil {
+		return m.Deletes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Value)(nil), "gnmi.sonic.Value")
 	proto.RegisterEnum("gnmi.sonic.State", State_name, State_value)
@@ -110,22 +129,24 @@ func init() {
 func init() { proto.RegisterFile("sonic_internal.proto", fileDescriptor2) }
 
 var fileDescriptor2 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x8f, 0x4f, 0x4b, 0xc3, 0x30,
-	0x18, 0xc6, 0xcd, 0xfa, 0xc7, 0xed, 0x9d, 0x42, 0x09, 0x3b, 0x04, 0x11, 0x29, 0xf5, 0x52, 0x14,
-	0x5a, 0xd1, 0xaf, 0xa0, 0x48, 0x2f, 0xb5, 0x64, 0xd5, 0xeb, 0xc8, 0x6a, 0xda, 0x06, 0xda, 0x24,
-	0xb4, 0x99, 0xb8, 0x6f, 0xe8, 0xc7, 0x92, 0xa6, 0x03, 0x0f, 0xde, 0xf2, 0xfc, 0x9e, 0xdf, 0x03,
-	0x79, 0x61, 0x33, 0x2a, 0x29, 0xaa, 0x9d, 0x90, 0x86, 0x0f, 0x92, 0x75, 0x89, 0x1e, 0x94, 0x51,
-	0x18, 0x1a, 0xd9, 0x8b, 0xc4, 0x56, 0x57, 0x0f, 0x8d, 0x30, 0xed, 0x61, 0x9f, 0x54, 0xaa, 0x4f,
-	0x95, 0xe6, 0xb2, 0x52, 0xb2, 0x16, 0x4d, 0x3a, 0x19, 0xa9, 0xb5, 0xe7, 0xa7, 0x5d, 0xd8, 0x1c,
-	0xfd, 0x20, 0xf0, 0x3e, 0x58, 0x77, 0xe0, 0x38, 0x02, 0x5f, 0x0f, 0xbc, 0x16, 0xdf, 0x04, 0x85,
-	0x28, 0x5e, 0x3f, 0x42, 0x62, 0xb5, 0x82, 0x99, 0x96, 0x9e, 0x1a, 0x7c, 0x03, 0xae, 0x66, 0xa6,
-	0x25, 0x8b, 0x7f, 0x86, 0xe5, 0xf8, 0x1a, 0x56, 0x46, 0xf4, 0x7c, 0x34, 0xac, 0xd7, 0xc4, 0x09,
-	0x51, 0xec, 0xd0, 0x3f, 0x80, 0x23, 0x70, 0xbe, 0x58, 0x47, 0x5c, 0x3b, 0x0e, 0xe6, 0x71, 0x79,
-	0xd4, 0xfc, 0xd3, 0x7e, 0x80, 0x4e, 0x25, 0xbe, 0x85, 0xcb, 0xf1, 0x28, 0xab, 0xdd, 0xc0, 0x47,
-	0xad, 0xe4, 0xc8, 0x89, 0x17, 0xa2, 0x78, 0x49, 0x2f, 0x26, 0x48, 0x4f, 0x0c, 0x6f, 0xc0, 0xab,
-	0x99, 0x61, 0x1d, 0xf1, 0x43, 0x14, 0xaf, 0xe8, 0x1c, 0xee, 0xee, 0xc1, 0xdb, 0x1a, 0x66, 0x38,
-	0x5e, 0xc3, 0xf9, 0xb6, 0x7c, 0x2b, 0x8a, 0x97, 0xe7, 0xe0, 0x0c, 0x2f, 0xc1, 0xcd, 0xf2, 0xac,
-	0x0c, 0xd0, 0x84, 0xe9, 0x7b, 0x9e, 0x67, 0xf9, 0x6b, 0xb0, 0xd8, 0xfb, 0xf6, 0xfc, 0xa7, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x14, 0x1d, 0x16, 0xfb, 0x54, 0x01, 0x00, 0x00,
+	// 303 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xd1, 0x6a, 0xc2, 0x30,
+	0x14, 0x86, 0x57, 0x6b, 0xad, 0x1e, 0x1d, 0x94, 0xe0, 0x45, 0x18, 0x63, 0x14, 0x37, 0x46, 0xd9,
+	0xa0, 0x0e, 0xf7, 0x0a, 0x1b, 0xc3, 0x9b, 0x4e, 0xa2, 0xee, 0x56, 0x62, 0x3d, 0x6a, 0xa0, 0x4d,
+	0x42, 0x13, 0xc7, 0x7c, 0x91, 0x3d, 0xef, 0x68, 0xaa, 0x0c, 0xb6, 0xbb, 0x9c, 0xef, 0xff, 0x7e,
+	0x92, 0x1c, 0x18, 0x1a, 0x25, 0x45, 0xbe, 0x12, 0xd2, 0x62, 0x25, 0x79, 0x91, 0xea, 0x4a, 0x59,
+	0x45, 0x60, 0x27, 0x4b, 0x91, 0xba, 0xe8, 0xea, 0x69, 0x27, 0xec, 0xfe, 0xb0, 0x4e, 0x73, 0x55,
+	0x8e, 0x95, 0x46, 0x99, 0x2b, 0xb9, 0x15, 0xbb, 0x71, 0x6d, 0x8c, 0x9d, 0xdd, 0x1c, 0x5d, 0xc3,
+	0xcd, 0xa3, 0xef, 0x16, 0x04, 0x1f, 0xbc, 0x38, 0x20, 0x19, 0x41, 0x47, 0x57, 0xb8, 0x15, 0x5f,
+	0xd4, 0x8b, 0xbd, 0xa4, 0x3f, 0x81, 0xd4, 0x69, 0x33, 0x6e, 0xf7, 0xec, 0x94, 0x90, 0x1b, 0x68,
+	0x6b, 0x6e, 0xf7, 0xb4, 0xf5, 0xcf, 0x70, 0x9c, 0x5c, 0x43, 0xcf, 0x8a, 0x12, 0x8d, 0xe5, 0xa5,
+	0xa6, 0x7e, 0xec, 0x25, 0x3e, 0xfb, 0x05, 0x64, 0x04, 0xfe, 0x27, 0x2f, 0x68, 0xdb, 0x95, 0xa3,
+	0xa6, 0xbc, 0x38, 0x6a, 0xdc, 0xb8, 0x07, 0xb0, 0x3a, 0x24, 0xb7, 0x70, 0x69, 0x8e, 0x32, 0x5f,
+	0x55, 0x68, 0xb4, 0x92, 0x06, 0x69, 0x10, 0x7b, 0x49, 0x97, 0x0d, 0x6a, 0xc8, 0x4e, 0x8c, 0x0c,
+	0x21, 0xd8, 0x72, 0xcb, 0x0b, 0xda, 0x89, 0xbd, 0xa4, 0xc7, 0x9a, 0x81, 0xdc, 0x43, 0x78, 0xd0,
+	0x1b, 0x6e, 0xd1, 0xd0, 0x30, 0xf6, 0x93, 0xfe, 0x64, 0xd0, 0x5c, 0xb1, 0x74, 0x90, 0x9d, 0x43,
+	0x72, 0x07, 0xe1, 0x06, 0x0b, 0xac, 0xbd, 0x6e, 0xec, 0xff, 0xf9, 0xc7, 0x39, 0x7a, 0x78, 0x84,
+	0x60, 0x6e, 0xb9, 0x45, 0xd2, 0x87, 0x70, 0xbe, 0x78, 0x9f, 0xcd, 0x5e, 0x5f, 0xa2, 0x0b, 0xd2,
+	0x85, 0xf6, 0x34, 0x9b, 0x2e, 0x22, 0xaf, 0xc6, 0x6c, 0x99, 0x65, 0xd3, 0xec, 0x2d, 0x6a, 0xad,
+	0x3b, 0x6e, 0x99, 0xcf, 0x3f, 0x03, 0x00, 0xe3, 0xa8, 0x76, 0x04, 0xa2, 0x01, 0x00, 0x00,
 }
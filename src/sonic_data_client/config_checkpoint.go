@@ -0,0 +1,499 @@
+package client
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+	log "github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	sdcfg "sonic_db_config"
+)
+
+// A checkpoint holds all entries of CONFIG_DB of a namespace, to restore
+// them later. Restoring a checkpoint rewrites only the fields changed
+// since, in one redis transaction: fields removed since are set back,
+// fields added are deleted, and an entry is deleted only if not in the
+// checkpoint. Entries and fields not changed are not seen changing by the
+// daemons subscribed to CONFIG_DB, and an entry in the checkpoint is never
+// seen deleted. The transaction fails, and restoring is retried, if an
+// entry to rewrite changes meanwhile.
+// Named checkpoints are saved as JSON files under CheckpointDir.
+
+// Directory of named config checkpoint files
+var CheckpointDir = "/var/lib/telemetry/config_checkpoints"
+
+// Attempts to restore a checkpoint while CONFIG_DB changes concurrently
+const restoreAttempts = 3
+
+// ConfigCheckpoint is the entries of CONFIG_DB of a namespace, per redis
+// key then field
+type ConfigCheckpoint struct {
+	Namespace string                       `json:"namespace"`
+	Timestamp int64                        `json:"timestamp"`
+	Entries   map[string]map[string]string `json:"entries"`
+}
+
+// ConfigChange tells how an entry or a field differs from a checkpoint
+type ConfigChange int
+
+// Values match the Change enum of gnoi.sonic.CheckpointDiff
+const (
+	ConfigModified ConfigChange = iota
+	// In CONFIG_DB, not in the checkpoint
+	ConfigAdded
+	// In the checkpoint, not in CONFIG_DB
+	ConfigRemoved
+)
+
+// ConfigFieldDiff is a field of an entry differing from a checkpoint
+type ConfigFieldDiff struct {
+	Field  string
+	Change ConfigChange
+	// Values in the checkpoint and in CONFIG_DB, "" when absent
+	Checkpoint string
+	Current    string
+}
+
+// ConfigEntryDiff is an entry of CONFIG_DB differing from a checkpoint
+type ConfigEntryDiff struct {
+	Table  string
+	Key    string
+	Change ConfigChange
+	Fields []ConfigFieldDiff
+}
+
+// Serializes access to checkpoint files
+var checkpointMu sync.Mutex
+
+// configDb returns the redis client of CONFIG_DB of namespace ns
+func configDb(ns string) (*redis.Client, error) {
+	// Testing program may ask to use redis local tcp connection
+	if UseRedisLocalTcpPort {
+		useRedisTcpClient()
+	}
+	redisDb, ok := Target2RedisDb[ns]["CONFIG_DB"]
+	if !ok {
+		return nil, errorf(codes.NotFound, "CONFIG_DB of namespace %q not found", ns)
+	}
+	return redisDb, nil
+}
+
+// configEntries returns all entries of CONFIG_DB of redisDb. Keys not of
+// hashes, ex. CONFIG_DB_INITIALIZED, are not entries.
+func configEntries(redisDb *redis.Client) (map[string]map[string]string, error) {
+	keys, err := redisDb.Keys("*").Result()
+	if err != nil {
+		return nil, err
+	}
+	pipe := redisDb.Pipeline()
+	defer pipe.Close()
+	cmds := make(map[string]*redis.StringStringMapCmd, len(keys))
+	for _, key := range keys {
+		cmds[key] = pipe.HGetAll(key)
+	}
+	// Errors of commands are checked one by one below
+	pipe.Exec()
+
+	entries := make(map[string]map[string]string, len(keys))
+	for key, cmd := range cmds {
+		fv, err := cmd.Result()
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "WRONGTYPE") {
+				continue
+			}
+			return nil, err
+		}
+		// Deleted since listed
+		if len(fv) == 0 {
+			continue
+		}
+		entries[key] = fv
+	}
+	return entries, nil
+}
+
+// TakeConfigCheckpoint returns a checkpoint of CONFIG_DB of namespace ns,
+// "" for the default namespace
+func TakeConfigCheckpoint(ns string) (*ConfigCheckpoint, error) {
+	if ns == "" {
+		ns = sdcfg.SONIC_DEFAULT_NAMESPACE
+	}
+	redisDb, err := configDb(ns)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := configEntries(redisDb)
+	if err != nil {
+		return nil, err
+	}
+	return &ConfigCheckpoint{
+		Namespace: ns,
+		Timestamp: time.Now().UnixNano(),
+		Entries:   entries,
+	}, nil
+}
+
+// sameFields tells whether entries a and b have the same fields and values,
+// a missing entry having none
+func sameFields(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for f, v := range a {
+		if bv, ok := b[f]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// changedKeys returns keys of entries differing from entries from to
+// entries to, sorted
+func changedKeys(from, to map[string]map[string]string) []string {
+	var keys []string
+	for key, fv := range from {
+		if !sameFields(fv, to[key]) {
+			keys = append(keys, key)
+		}
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Restore restores CONFIG_DB to cp, rewriting the entries changed since
+// in one transaction. It returns the keys of the entries rewritten.
+func (cp *ConfigCheckpoint) Restore() ([]string, error) {
+	redisDb, err := configDb(cp.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 1; ; attempt++ {
+		keys, err := cp.restore(redisDb)
+		if err == redis.TxFailedErr && attempt < restoreAttempts {
+			log.V(2).Infof("CONFIG_DB changed while restoring checkpoint, retrying")
+			continue
+		}
+		if err == redis.TxFailedErr {
+			return nil, errorf(codes.Aborted, "CONFIG_DB of namespace %q kept changing while restoring checkpoint", cp.Namespace)
+		}
+		if err != nil {
+			return nil, errorf(codes.Internal, "Failed to restore CONFIG_DB of namespace %q: %v", cp.Namespace, err)
+		}
+		log.V(1).Infof("Restored %v entries of CONFIG_DB of namespace %q to checkpoint of %v",
+			len(keys), cp.Namespace, time.Unix(0, cp.Timestamp))
+		return keys, nil
+	}
+}
+
+// restore rewrites the entries of redisDb differing from cp in one
+// transaction, failing with redis.TxFailedErr if any of them changes
+// meanwhile
+func (cp *ConfigCheckpoint) restore(redisDb *redis.Client) ([]string, error) {
+	cur, err := configEntries(redisDb)
+	if err != nil {
+		return nil, err
+	}
+	keys := changedKeys(cur, cp.Entries)
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	err = redisDb.Watch(func(tx *redis.Tx) error {
+		// Changed before being watched
+		for _, key := range keys {
+			fv, err := tx.HGetAll(key).Result()
+			if err != nil {
+				return err
+			}
+			if !sameFields(fv, cur[key]) {
+				return redis.TxFailedErr
+			}
+		}
+		_, err := tx.TxPipelined(func(pipe redis.Pipeliner) error {
+			for _, key := range keys {
+				rewriteEntry(pipe, key, cur[key], cp.Entries[key])
+			}
+			return nil
+		})
+		return err
+	}, keys...)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// ConfigDelta is the changes made to entries of CONFIG_DB of a namespace,
+// to revert them later without reverting changes made to other entries
+type ConfigDelta struct {
+	Namespace string `json:"namespace"`
+	// Entries changed, before and after the changes, by redis key. Entries
+	// absent are missing from these.
+	Before map[string]map[string]string `json:"before"`
+	After  map[string]map[string]string `json:"after"`
+}
+
+// Delta returns the changes made to CONFIG_DB since cp
+func (cp *ConfigCheckpoint) Delta() (*ConfigDelta, error) {
+	redisDb, err := configDb(cp.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	cur, err := configEntries(redisDb)
+	if err != nil {
+		return nil, errorf(codes.Unavailable, "Failed to read CONFIG_DB of namespace %q: %v", cp.Namespace, err)
+	}
+	d := &ConfigDelta{
+		Namespace: cp.Namespace,
+		Before:    make(map[string]map[string]string),
+		After:     make(map[string]map[string]string),
+	}
+	for _, key := range changedKeys(cp.Entries, cur) {
+		if fv, ok := cp.Entries[key]; ok {
+			d.Before[key] = fv
+		}
+		if fv, ok := cur[key]; ok {
+			d.After[key] = fv
+		}
+	}
+	return d, nil
+}
+
+// Revert reverts the changes of d in one transaction, rewriting the fields
+// changed. It fails with Aborted, changing nothing, if any of the entries
+// changed has changed since. It returns the keys of the entries rewritten.
+func (d *ConfigDelta) Revert() ([]string, error) {
+	redisDb, err := configDb(d.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	keys := changedKeys(d.Before, d.After)
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	for attempt := 1; ; attempt++ {
+		err = redisDb.Watch(func(tx *redis.Tx) error {
+			for _, key := range keys {
+				fv, err := tx.HGetAll(key).Result()
+				if err != nil {
+					return err
+				}
+				if !sameFields(fv, d.After[key]) {
+					return errorf(codes.Aborted, "Entry %v of CONFIG_DB changed since, not reverting", key)
+				}
+			}
+			_, err := tx.TxPipelined(func(pipe redis.Pipeliner) error {
+				for _, key := range keys {
+					rewriteEntry(pipe, key, d.After[key], d.Before[key])
+				}
+				return nil
+			})
+			return err
+		}, keys...)
+		if err == redis.TxFailedErr && attempt < restoreAttempts {
+			continue
+		}
+		if err == redis.TxFailedErr {
+			return nil, errorf(codes.Aborted, "CONFIG_DB of namespace %q kept changing while reverting", d.Namespace)
+		}
+		if _, ok := err.(*codeError); ok {
+			return nil, err
+		}
+		if err != nil {
+			return nil, errorf(codes.Internal, "Failed to revert changes of CONFIG_DB of namespace %q: %v", d.Namespace, err)
+		}
+		log.V(1).Infof("Reverted %v entries of CONFIG_DB of namespace %q", len(keys), d.Namespace)
+		return keys, nil
+	}
+}
+
+// rewriteEntry queues the commands rewriting entry key from fields from to
+// fields to, none to delete it. Fields are set before others are deleted
+// for the entry not to be deleted on its last field deleted.
+func rewriteEntry(pipe redis.Pipeliner, key string, from, to map[string]string) {
+	if len(to) == 0 {
+		pipe.Del(key)
+		return
+	}
+	set := make(map[string]interface{})
+	for f, v := range to {
+		if fv, ok := from[f]; !ok || fv != v {
+			set[f] = v
+		}
+	}
+	var del []string
+	for f := range from {
+		if _, ok := to[f]; !ok {
+			del = append(del, f)
+		}
+	}
+	if len(set) > 0 {
+		pipe.HMSet(key, set)
+	}
+	if len(del) > 0 {
+		sort.Strings(del)
+		pipe.HDel(key, del...)
+	}
+}
+
+// Diff returns the entries of CONFIG_DB differing from cp, by table then
+// key
+func (cp *ConfigCheckpoint) Diff() ([]ConfigEntryDiff, error) {
+	redisDb, err := configDb(cp.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	cur, err := configEntries(redisDb)
+	if err != nil {
+		return nil, err
+	}
+	separator, err := sdcfg.GetDbSeparator("CONFIG_DB", cp.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []ConfigEntryDiff
+	for _, key := range changedKeys(cp.Entries, cur) {
+		diff := ConfigEntryDiff{Table: key}
+		if i := strings.Index(key, separator); separator != "" && i >= 0 {
+			diff.Table, diff.Key = key[:i], key[i+len(separator):]
+		}
+		old, inOld := cp.Entries[key]
+		now, inNow := cur[key]
+		switch {
+		case !inOld:
+			diff.Change = ConfigAdded
+		case !inNow:
+			diff.Change = ConfigRemoved
+		}
+		diff.Fields = fieldDiffs(old, now)
+		diffs = append(diffs, diff)
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].Table != diffs[j].Table {
+			return diffs[i].Table < diffs[j].Table
+		}
+		return diffs[i].Key < diffs[j].Key
+	})
+	return diffs, nil
+}
+
+// fieldDiffs returns the fields differing from old to now, sorted
+func fieldDiffs(old, now map[string]string) []ConfigFieldDiff {
+	var diffs []ConfigFieldDiff
+	for f, v := range old {
+		nv, ok := now[f]
+		switch {
+		case !ok:
+			diffs = append(diffs, ConfigFieldDiff{Field: f, Change: ConfigRemoved, Checkpoint: v})
+		case nv != v:
+			diffs = append(diffs, ConfigFieldDiff{Field: f, Change: ConfigModified, Checkpoint: v, Current: nv})
+		}
+	}
+	for f, v := range now {
+		if _, ok := old[f]; !ok {
+			diffs = append(diffs, ConfigFieldDiff{Field: f, Change: ConfigAdded, Current: v})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Field < diffs[j].Field })
+	return diffs
+}
+
+// checkpointFile returns the file of checkpoint name
+func checkpointFile(name string) (string, error) {
+	if name == "" || filepath.Base(name) != name || strings.HasPrefix(name, ".") {
+		return "", errorf(codes.InvalidArgument, "Invalid config checkpoint name %q", name)
+	}
+	return filepath.Join(CheckpointDir, name+".json"), nil
+}
+
+// SaveConfigCheckpoint saves a checkpoint of CONFIG_DB of namespace ns as
+// checkpoint name, replacing the one of the same name
+func SaveConfigCheckpoint(name string, ns string) (*ConfigCheckpoint, error) {
+	fileName, err := checkpointFile(name)
+	if err != nil {
+		return nil, err
+	}
+	cp, err := TakeConfigCheckpoint(ns)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpointMu.Lock()
+	defer checkpointMu.Unlock()
+	if err = os.MkdirAll(CheckpointDir, 0700); err != nil {
+		return nil, errorf(codes.Internal, "Failed to save config checkpoint %q: %v", name, err)
+	}
+	// Replace the file at once not to leave it partially written
+	tmpName := fileName + ".tmp"
+	if err = ioutil.WriteFile(tmpName, data, 0600); err != nil {
+		return nil, errorf(codes.Internal, "Failed to save config checkpoint %q: %v", name, err)
+	}
+	if err = os.Rename(tmpName, fileName); err != nil {
+		return nil, errorf(codes.Internal, "Failed to save config checkpoint %q: %v", name, err)
+	}
+	log.V(2).Infof("Saved config checkpoint %q of namespace %q with %v entries", name, cp.Namespace, len(cp.Entries))
+	return cp, nil
+}
+
+// LoadConfigCheckpoint returns checkpoint name
+func LoadConfigCheckpoint(name string) (*ConfigCheckpoint, error) {
+	fileName, err := checkpointFile(name)
+	if err != nil {
+		return nil, err
+	}
+	checkpointMu.Lock()
+	defer checkpointMu.Unlock()
+	return loadCheckpointFile(fileName)
+}
+
+// loadCheckpointFile returns the checkpoint of fileName. checkpointMu must
+// be held.
+func loadCheckpointFile(fileName string) (*ConfigCheckpoint, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return nil, errorf(codes.NotFound, "Config checkpoint %q not found", strings.TrimSuffix(filepath.Base(fileName), ".json"))
+	}
+	if err != nil {
+		return nil, errorf(codes.Internal, "Failed to read %v: %v", fileName, err)
+	}
+	cp := &ConfigCheckpoint{}
+	if err = json.Unmarshal(data, cp); err != nil {
+		return nil, errorf(codes.Internal, "%v: Invalid config checkpoint: %v", fileName, err)
+	}
+	return cp, nil
+}
+
+// ListConfigCheckpoints returns the saved checkpoints by name
+func ListConfigCheckpoints() (map[string]*ConfigCheckpoint, error) {
+	checkpointMu.Lock()
+	defer checkpointMu.Unlock()
+	fileNames, err := filepath.Glob(filepath.Join(CheckpointDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	checkpoints := make(map[string]*ConfigCheckpoint)
+	for _, fileName := range fileNames {
+		cp, err := loadCheckpointFile(fileName)
+		if err != nil {
+			log.V(1).Infof("Skipping config checkpoint: %v", err)
+			continue
+		}
+		checkpoints[strings.TrimSuffix(filepath.Base(fileName), ".json")] = cp
+	}
+	return checkpoints, nil
+}
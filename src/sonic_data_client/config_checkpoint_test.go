@@ -0,0 +1,173 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/kylelemons/godebug/pretty"
+	"google.golang.org/grpc/codes"
+)
+
+// Prerequisite: redis-server should be running on localhost.
+
+const checkpointTestKey = "CHECKPOINT_TEST|Ethernet0"
+
+func TestRewriteEntry(t *testing.T) {
+	testCountersDb(t, "")
+	redisDb, err := configDb("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer redisDb.Del(checkpointTestKey)
+
+	tests := []struct {
+		desc     string
+		from, to map[string]string
+		// Commands queued
+		want []string
+	}{{
+		desc: "field changed",
+		from: map[string]string{"mtu": "9100", "speed": "100000"},
+		to:   map[string]string{"mtu": "1500", "speed": "100000"},
+		want: []string{"hmset CHECKPOINT_TEST|Ethernet0 mtu 1500"},
+	}, {
+		desc: "fields added and removed",
+		from: map[string]string{"mtu": "1500", "fec": "rs"},
+		to:   map[string]string{"mtu": "1500", "speed": "100000"},
+		want: []string{
+			"hmset CHECKPOINT_TEST|Ethernet0 speed 100000",
+			"hdel CHECKPOINT_TEST|Ethernet0 fec",
+		},
+	}, {
+		desc: "all fields replaced",
+		from: map[string]string{"fec": "rs"},
+		to:   map[string]string{"mtu": "9100"},
+		want: []string{
+			"hmset CHECKPOINT_TEST|Ethernet0 mtu 9100",
+			"hdel CHECKPOINT_TEST|Ethernet0 fec",
+		},
+	}, {
+		desc: "entry removed",
+		from: map[string]string{"mtu": "9100"},
+		want: []string{"del CHECKPOINT_TEST|Ethernet0"},
+	}, {
+		desc: "entry added",
+		to:   map[string]string{"mtu": "1500"},
+		want: []string{"hmset CHECKPOINT_TEST|Ethernet0 mtu 1500"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			redisDb.Del(checkpointTestKey)
+			for f, v := range tt.from {
+				redisDb.HSet(checkpointTestKey, f, v)
+			}
+			cmds, err := redisDb.TxPipelined(func(pipe redis.Pipeliner) error {
+				rewriteEntry(pipe, checkpointTestKey, tt.from, tt.to)
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, cmd := range cmds {
+				s := cmd.Name()
+				for _, arg := range cmd.Args()[1:] {
+					s += " " + arg.(string)
+				}
+				got = append(got, s)
+			}
+			if diff := pretty.Compare(tt.want, got); diff != "" {
+				t.Errorf("unexpected commands (-want +got):\n%s", diff)
+			}
+			fv, err := redisDb.HGetAll(checkpointTestKey).Result()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !sameFields(fv, tt.to) {
+				t.Errorf("got entry %v, want %v", fv, tt.to)
+			}
+		})
+	}
+}
+
+func TestConfigDelta(t *testing.T) {
+	testCountersDb(t, "")
+	redisDb, err := configDb("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const addedKey = "CHECKPOINT_TEST|Ethernet4"
+	const otherKey = "CHECKPOINT_TEST|Ethernet8"
+	defer redisDb.Del(checkpointTestKey, addedKey, otherKey)
+	redisDb.Del(checkpointTestKey, addedKey, otherKey)
+	redisDb.HSet(checkpointTestKey, "mtu", "9100")
+	redisDb.HSet(checkpointTestKey, "speed", "100000")
+
+	change := func() *ConfigDelta {
+		cp, err := TakeConfigCheckpoint("")
+		if err != nil {
+			t.Fatal(err)
+		}
+		redisDb.HSet(checkpointTestKey, "mtu", "1500")
+		redisDb.HSet(addedKey, "mtu", "1500")
+		d, err := cp.Delta()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	entry := func(key string) map[string]string {
+		fv, err := redisDb.HGetAll(key).Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return fv
+	}
+
+	t.Run("revert", func(t *testing.T) {
+		d := change()
+		want := &ConfigDelta{
+			Namespace: d.Namespace,
+			Before: map[string]map[string]string{
+				checkpointTestKey: {"mtu": "9100", "speed": "100000"},
+			},
+			After: map[string]map[string]string{
+				checkpointTestKey: {"mtu": "1500", "speed": "100000"},
+				addedKey:          {"mtu": "1500"},
+			},
+		}
+		if diff := pretty.Compare(want, d); diff != "" {
+			t.Fatalf("unexpected delta (-want +got):\n%s", diff)
+		}
+		// Changes of other entries are kept
+		redisDb.HSet(otherKey, "mtu", "1500")
+		keys, err := d.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keys) != 2 {
+			t.Errorf("got keys %v reverted, want 2", keys)
+		}
+		if fv := entry(checkpointTestKey); !sameFields(fv, want.Before[checkpointTestKey]) {
+			t.Errorf("got %v, want %v", fv, want.Before[checkpointTestKey])
+		}
+		if fv := entry(addedKey); len(fv) != 0 {
+			t.Errorf("got %v, want entry added deleted", fv)
+		}
+		if fv := entry(otherKey); fv["mtu"] != "1500" {
+			t.Errorf("got %v, want change of other entry kept", fv)
+		}
+	})
+
+	t.Run("changed since", func(t *testing.T) {
+		d := change()
+		redisDb.HSet(addedKey, "speed", "40000")
+		_, err := d.Revert()
+		if code := ErrorCode(err); code != codes.Aborted {
+			t.Fatalf("got %v, want Aborted", err)
+		}
+		if fv := entry(checkpointTestKey); fv["mtu"] != "1500" {
+			t.Errorf("got %v, want entry not reverted", fv)
+		}
+	})
+}
@@ -0,0 +1,315 @@
+package client
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc/codes"
+)
+
+// Like "portstat -c", a user may save counters in COUNTERS_DB as a named
+// baseline. Paths having key "baseline" in any element, ex.
+// COUNTERS/Ethernet68[baseline=mine], get counters relative to the
+// baseline of the user. Baselines of each user persist in a JSON file
+// under BaselineDir.
+
+// Directory of counter baseline files
+var BaselineDir = "/var/lib/telemetry/counter_baselines"
+
+const (
+	// Path element key selecting the counter baseline
+	baselinePathKey = "baseline"
+	// Owner of baselines when user authentication is not enabled
+	defaultBaselineUser = "default"
+)
+
+// Counter values of a baseline, per namespace, redis key then field
+type counterBaseline struct {
+	Timestamp int64                                   `json:"timestamp"`
+	Counters  map[string]map[string]map[string]string `json:"counters"`
+}
+
+var (
+	baselineMu sync.Mutex
+	// Baselines loaded per user then name
+	userBaselines = make(map[string]map[string]*counterBaseline)
+)
+
+func baselineUser(user string) string {
+	if user == "" {
+		return defaultBaselineUser
+	}
+	return user
+}
+
+// baselineFile returns the file keeping baselines of user
+func baselineFile(user string) (string, error) {
+	if filepath.Base(user) != user || strings.HasPrefix(user, ".") {
+		return "", errorf(codes.InvalidArgument, "Invalid user name %q for counter baseline", user)
+	}
+	return filepath.Join(BaselineDir, user+".json"), nil
+}
+
+// loadUserBaselines returns baselines of user, loading them from its file
+// upon first use. baselineMu must be held.
+func loadUserBaselines(user string) (map[string]*counterBaseline, error) {
+	if baselines, ok := userBaselines[user]; ok {
+		return baselines, nil
+	}
+	fileName, err := baselineFile(user)
+	if err != nil {
+		return nil, err
+	}
+	baselines := make(map[string]*counterBaseline)
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errorf(codes.Internal, "Failed to read %v: %v", fileName, err)
+	}
+	if err == nil {
+		if err = json.Unmarshal(data, &baselines); err != nil {
+			return nil, errorf(codes.Internal, "%v: Invalid counter baselines: %v", fileName, err)
+		}
+	}
+	userBaselines[user] = baselines
+	return baselines, nil
+}
+
+// storeUserBaselines writes baselines of user to its file. baselineMu must
+// be held.
+func storeUserBaselines(user string, baselines map[string]*counterBaseline) error {
+	fileName, err := baselineFile(user)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(baselines)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(BaselineDir, 0700); err != nil {
+		return err
+	}
+	// Replace the file at once not to leave it partially written
+	tmpName := fileName + ".tmp"
+	if err = ioutil.WriteFile(tmpName, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpName, fileName)
+}
+
+// getCounterBaseline returns baseline name of user
+func getCounterBaseline(user string, name string) (*counterBaseline, error) {
+	baselineMu.Lock()
+	defer baselineMu.Unlock()
+	user = baselineUser(user)
+	baselines, err := loadUserBaselines(user)
+	if err != nil {
+		return nil, err
+	}
+	bl, ok := baselines[name]
+	if !ok {
+		return nil, errorf(codes.NotFound, "Counter baseline %q of user %v not found", name, user)
+	}
+	return bl, nil
+}
+
+// SaveCounterBaseline saves counters of paths in COUNTERS_DB of namespace
+// as baseline name of user, replacing the one of the same name. Paths are
+// "/" separated, ex. "COUNTERS/Ethernet*". It returns the number of
+// counters keys saved.
+func SaveCounterBaseline(user string, name string, namespace string, paths []string) (int, error) {
+	if name == "" {
+		return 0, errorf(codes.InvalidArgument, "Empty counter baseline name")
+	}
+	if len(paths) == 0 {
+		return 0, errorf(codes.InvalidArgument, "No path for counter baseline %q", name)
+	}
+	// Testing program may ask to use redis local tcp connection
+	if UseRedisLocalTcpPort {
+		useRedisTcpClient()
+	}
+
+	prefix := &gnmipb.Path{Target: "COUNTERS_DB", Origin: namespace}
+	var gnmiPaths []*gnmipb.Path
+	for _, p := range paths {
+		path := &gnmipb.Path{}
+		for _, elem := range strings.Split(strings.Trim(p, "/"), "/") {
+			if elem == "" {
+				return 0, errorf(codes.InvalidArgument, "Invalid counter baseline path %q", p)
+			}
+			path.Elem = append(path.Elem, &gnmipb.PathElem{Name: elem})
+		}
+		gnmiPaths = append(gnmiPaths, path)
+	}
+	pathG2S := make(map[*gnmipb.Path][]tablePath)
+	if err := populateAllDbtablePath(prefix, gnmiPaths, &pathG2S); err != nil {
+		return 0, err
+	}
+
+	bl := &counterBaseline{
+		Timestamp: time.Now().UnixNano(),
+		Counters:  make(map[string]map[string]map[string]string),
+	}
+	var numKeys int
+	for _, tblPaths := range pathG2S {
+		for i := range tblPaths {
+			tblPath := &tblPaths[i]
+			redisDb := Target2RedisDb[tblPath.dbNamespace][tblPath.dbName]
+			dbkeys, err := tablePathKeys(tblPath)
+			if err != nil {
+				return 0, errorf(codes.Unavailable, "%v", err)
+			}
+			nsCounters, ok := bl.Counters[tblPath.dbNamespace]
+			if !ok {
+				nsCounters = make(map[string]map[string]string)
+				bl.Counters[tblPath.dbNamespace] = nsCounters
+			}
+			for _, dbkey := range dbkeys {
+				if _, ok := nsCounters[dbkey]; ok {
+					continue
+				}
+				fv, err := redisDb.HGetAll(dbkey).Result()
+				if err != nil {
+					log.V(2).Infof("redis HGetAll failed for %v, dbkey %s", tblPath, dbkey)
+					return 0, errorf(codes.Unavailable, "redis HGetAll failed for %v: %v", dbkey, err)
+				}
+				counters := make(map[string]string)
+				for f, v := range fv {
+					if _, err := strconv.ParseUint(v, 10, 64); err == nil {
+						counters[f] = v
+					}
+				}
+				nsCounters[dbkey] = counters
+				numKeys++
+			}
+		}
+	}
+
+	baselineMu.Lock()
+	defer baselineMu.Unlock()
+	user = baselineUser(user)
+	baselines, err := loadUserBaselines(user)
+	if err != nil {
+		return 0, err
+	}
+	baselines[name] = bl
+	if err = storeUserBaselines(user, baselines); err != nil {
+		delete(baselines, name)
+		return 0, errorf(codes.Internal, "Failed to save counter baseline %q: %v", name, err)
+	}
+	log.V(2).Infof("Saved counter baseline %q of user %v with %v keys", name, user, numKeys)
+	return numKeys, nil
+}
+
+// DeleteCounterBaseline deletes baseline name of user
+func DeleteCounterBaseline(user string, name string) error {
+	baselineMu.Lock()
+	defer baselineMu.Unlock()
+	user = baselineUser(user)
+	baselines, err := loadUserBaselines(user)
+	if err != nil {
+		return err
+	}
+	bl, ok := baselines[name]
+	if !ok {
+		return errorf(codes.NotFound, "Counter baseline %q of user %v not found", name, user)
+	}
+	delete(baselines, name)
+	if err = storeUserBaselines(user, baselines); err != nil {
+		baselines[name] = bl
+		return errorf(codes.Internal, "Failed to delete counter baseline %q: %v", name, err)
+	}
+	log.V(2).Infof("Deleted counter baseline %q of user %v", name, user)
+	return nil
+}
+
+// pathBaselineName returns the baseline asked by elements of prefix and path
+func pathBaselineName(prefix, path *gnmipb.Path) string {
+	var name string
+	for _, elems := range [][]*gnmipb.PathElem{prefix.GetElem(), path.GetElem()} {
+		for _, elem := range elems {
+			if val, ok := elem.GetKey()[baselinePathKey]; ok {
+				name = val
+			}
+		}
+	}
+	return name
+}
+
+// setBaseline makes counters of tblPaths relative to the baseline asked by
+// gnmiPath, if any.
+func (c *DbClient) setBaseline(gnmiPath *gnmipb.Path, tblPaths []tablePath) error {
+	name := pathBaselineName(c.prefix, gnmiPath)
+	if name == "" {
+		return nil
+	}
+	if c.prefix.GetTarget() != "COUNTERS_DB" {
+		return errorf(codes.InvalidArgument, "Counter baseline is not supported for target %v", c.prefix.GetTarget())
+	}
+	bl, err := getCounterBaseline(c.user, name)
+	if err != nil {
+		return err
+	}
+	for i := range tblPaths {
+		tblPaths[i].baseline = bl
+	}
+	return nil
+}
+
+// relative returns counter val of field of key in namespace ns relative to
+// the baseline. Values not saved in the baseline are kept as is.
+func (bl *counterBaseline) relative(ns string, key string, field string, val string) string {
+	base, ok := bl.Counters[ns][key][field]
+	if !ok {
+		return val
+	}
+	b, err := strconv.ParseUint(base, 10, 64)
+	if err != nil {
+		return val
+	}
+	v, err := strconv.ParseUint(val, 10, 64)
+	if err != nil {
+		return val
+	}
+	// Counters cleared since the baseline restart from 0
+	return strconv.FormatUint(counterDelta(b, v), 10)
+}
+
+// baselineValue returns val of field of key for tblPath, relative to its
+// baseline if any
+func baselineValue(tblPath *tablePath, key string, field string, val string) string {
+	if tblPath.baseline == nil {
+		return val
+	}
+	return tblPath.baseline.relative(tblPath.dbNamespace, key, field, val)
+}
+
+// hget reads field of key for tblPath, relative to its baseline if any
+func hget(tblPath *tablePath, key string, field string) (string, error) {
+	redisDb := Target2RedisDb[tblPath.dbNamespace][tblPath.dbName]
+	val, err := redisDb.HGet(key, field).Result()
+	if err != nil {
+		return val, err
+	}
+	return baselineValue(tblPath, key, field, val), nil
+}
+
+// hgetAll reads all fields of key for tblPath, relative to its baseline if any
+func hgetAll(tblPath *tablePath, key string) (map[string]string, error) {
+	redisDb := Target2RedisDb[tblPath.dbNamespace][tblPath.dbName]
+	fv, err := redisDb.HGetAll(key).Result()
+	if err != nil || tblPath.baseline == nil {
+		return fv, err
+	}
+	for f, v := range fv {
+		fv[f] = tblPath.baseline.relative(tblPath.dbNamespace, key, f, v)
+	}
+	return fv, nil
+}
@@ -0,0 +1,221 @@
+package client
+
+import (
+	"strconv"
+	"time"
+
+	log "github.com/golang/glog"
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+	spb "proto"
+)
+
+// Rates of port counters are computed from two consecutive samples of the
+// counters taken by each client, ex. for path COUNTERS/Ethernet*/Rates.
+
+// Minimal interval between the two samples of a rate. The first value of
+// a client is delayed until the interval elapses after the first sample.
+const rateMinInterval = time.Second
+
+// A rate and the counters summed for it, scaled ex. from octets to bits
+type rateDef struct {
+	name     string
+	counters []string
+	scale    float64
+}
+
+var portRateDefs = []rateDef{
+	{"RX_BPS", []string{"SAI_PORT_STAT_IF_IN_OCTETS"}, 8},
+	{"TX_BPS", []string{"SAI_PORT_STAT_IF_OUT_OCTETS"}, 8},
+	{"RX_PPS", []string{"SAI_PORT_STAT_IF_IN_UCAST_PKTS", "SAI_PORT_STAT_IF_IN_NON_UCAST_PKTS"}, 1},
+	{"TX_PPS", []string{"SAI_PORT_STAT_IF_OUT_UCAST_PKTS", "SAI_PORT_STAT_IF_OUT_NON_UCAST_PKTS"}, 1},
+	{"RX_ERR_PPS", []string{"SAI_PORT_STAT_IF_IN_ERRORS"}, 1},
+	{"TX_ERR_PPS", []string{"SAI_PORT_STAT_IF_OUT_ERRORS"}, 1},
+	{"RX_DROP_PPS", []string{"SAI_PORT_STAT_IF_IN_DISCARDS"}, 1},
+	{"TX_DROP_PPS", []string{"SAI_PORT_STAT_IF_OUT_DISCARDS"}, 1},
+}
+
+// Counter values of a table path at a time
+type counterSample struct {
+	values map[string]uint64
+	ts     time.Time
+}
+
+// readCounterSample reads counters of tblPath from redis
+func readCounterSample(tblPath *tablePath) (*counterSample, error) {
+	redisDb := Target2RedisDb[tblPath.dbNamespace][tblPath.dbName]
+	key := tblPath.tableName + tblPath.delimitor + tblPath.tableKey
+	fv, err := redisDb.HGetAll(key).Result()
+	if err != nil {
+		log.V(2).Infof("redis HGetAll failed for %v, dbkey %s", tblPath, key)
+		return nil, err
+	}
+	sample := &counterSample{values: make(map[string]uint64), ts: time.Now()}
+	for f, v := range fv {
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			// Not a counter
+			continue
+		}
+		sample.values[f] = n
+	}
+	return sample, nil
+}
+
+// counterDelta returns the increase of a counter from prev to cur. A
+// counter going back is taken as wrapped if prev is in the upper half of
+// 64-bit range, otherwise as cleared and restarted from 0.
+func counterDelta(prev, cur uint64) uint64 {
+	if cur >= prev || prev >= 1<<63 {
+		// Wrap is handled by unsigned arithmetic
+		return cur - prev
+	}
+	return cur
+}
+
+// computeRates returns rates of counters between samples prev and cur.
+// Rates missing any of their counters are left out.
+func computeRates(prev, cur *counterSample) map[string]string {
+	rates := make(map[string]string)
+	secs := cur.ts.Sub(prev.ts).Seconds()
+	if secs <= 0 {
+		return rates
+	}
+	for _, def := range portRateDefs {
+		var delta uint64
+		found := true
+		for _, counter := range def.counters {
+			p, ok1 := prev.values[counter]
+			c, ok2 := cur.values[counter]
+			if !ok1 || !ok2 {
+				found = false
+				break
+			}
+			delta += counterDelta(p, c)
+		}
+		if found {
+			rates[def.name] = strconv.FormatFloat(float64(delta)*def.scale/secs, 'f', 2, 64)
+		}
+	}
+	return rates
+}
+
+// isRatesPath tells whether tblPaths are for rates of counters
+func isRatesPath(tblPaths []tablePath) bool {
+	return len(tblPaths) > 0 && tblPaths[0].rates
+}
+
+// sampleCounters reads counters of all tblPaths, making sure each of them
+// has a previous sample taken at least rateMinInterval ago.
+func (c *DbClient) sampleCounters(tblPaths []tablePath) (map[tablePath]*counterSample, error) {
+	read := func() (map[tablePath]*counterSample, error) {
+		samples := make(map[tablePath]*counterSample)
+		for i := range tblPaths {
+			sample, err := readCounterSample(&tblPaths[i])
+			if err != nil {
+				return nil, err
+			}
+			samples[tblPaths[i]] = sample
+		}
+		return samples, nil
+	}
+
+	samples, err := read()
+	if err != nil {
+		return nil, err
+	}
+	var wait time.Duration
+	c.mu.Lock()
+	for tblPath, sample := range samples {
+		prev, ok := c.rateSamples[tblPath]
+		if !ok {
+			// First sample as the base of rates
+			c.rateSamples[tblPath] = sample
+			prev = sample
+		}
+		if w := rateMinInterval - sample.ts.Sub(prev.ts); w > wait {
+			wait = w
+		}
+	}
+	c.mu.Unlock()
+	if wait <= 0 {
+		return samples, nil
+	}
+	time.Sleep(wait)
+	return read()
+}
+
+// rates2TypedValue returns rates of counters in tblPaths since they were
+// sampled last time by the client.
+func (c *DbClient) rates2TypedValue(tblPaths []tablePath) (*gnmipb.TypedValue, error) {
+	samples, err := c.sampleCounters(tblPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	msi := make(map[string]interface{})
+	c.mu.Lock()
+	for i := range tblPaths {
+		tblPath := &tblPaths[i]
+		cur := samples[*tblPath]
+		rates := computeRates(c.rateSamples[*tblPath], cur)
+		c.rateSamples[*tblPath] = cur
+
+		m := nsMsi(msi, tblPath.jsonNamespace)
+		if tblPath.jsonTableKey != "" {
+			makeJSON_redis(&m, &tblPath.jsonTableKey, nil, rates)
+		} else {
+			makeJSON_redis(&m, nil, nil, rates)
+		}
+	}
+	c.mu.Unlock()
+	return msi2TypedValue(msi)
+}
+
+// pathTypedValue returns the current value of tblPaths
+func (c *DbClient) pathTypedValue(tblPaths []tablePath) (*gnmipb.TypedValue, error) {
+	if isRatesPath(tblPaths) {
+		return c.rates2TypedValue(tblPaths)
+	}
+	return tableData2TypedValue(tblPaths, nil)
+}
+
+// dbRatesSubscribe sends rates of counters in tblPaths every interval.
+func dbRatesSubscribe(gnmiPath *gnmipb.Path, tblPaths []tablePath, interval time.Duration, stop chan struct{}, c *DbClient) {
+	defer c.w.Done()
+	if interval < rateMinInterval {
+		interval = rateMinInterval
+	}
+
+	synced := false
+	for {
+		val, err := c.rates2TypedValue(tblPaths)
+		if err != nil {
+			enqueFatalMsg(c, err.Error())
+			return
+		}
+		spbv := &spb.Value{
+			Prefix:    c.prefix,
+			Path:      gnmiPath,
+			Timestamp: time.Now().UnixNano(),
+			Val:       val,
+		}
+		if err = c.q.Put(Value{spbv}); err != nil {
+			log.V(1).Infof("Queue error:  %v", err)
+			return
+		}
+		if !synced {
+			c.synced.Done()
+			synced = true
+		}
+
+		select {
+		case <-c.channel:
+			log.V(1).Infof("Stopping dbRatesSubscribe routine for Client %s ", c)
+			return
+		case <-stop:
+			log.V(1).Infof("Stopping dbRatesSubscribe routine for %v ", gnmiPath)
+			return
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCounterDelta(t *testing.T) {
+	tests := []struct {
+		desc      string
+		prev, cur uint64
+		want      uint64
+	}{
+		{"increase", 100, 150, 50},
+		{"unchanged", 100, 100, 0},
+		{"cleared", 1000, 30, 30},
+		{"cleared to 0", 1000, 0, 0},
+		{"64-bit wrap", math.MaxUint64 - 9, 5, 15},
+		{"wrap from upper half", 1 << 63, 1, 1<<63 + 1},
+	}
+	for _, tt := range tests {
+		if got := counterDelta(tt.prev, tt.cur); got != tt.want {
+			t.Errorf("%v: counterDelta(%v, %v) = %v, want %v", tt.desc, tt.prev, tt.cur, got, tt.want)
+		}
+	}
+}
+
+func TestComputeRates(t *testing.T) {
+	ts := time.Now()
+	prev := &counterSample{
+		values: map[string]uint64{
+			"SAI_PORT_STAT_IF_IN_OCTETS":          1000,
+			"SAI_PORT_STAT_IF_OUT_OCTETS":         math.MaxUint64 - 99,
+			"SAI_PORT_STAT_IF_IN_UCAST_PKTS":      500,
+			"SAI_PORT_STAT_IF_IN_NON_UCAST_PKTS":  10,
+			"SAI_PORT_STAT_IF_OUT_UCAST_PKTS":     100,
+			"SAI_PORT_STAT_IF_OUT_NON_UCAST_PKTS": 100,
+			"SAI_PORT_STAT_IF_IN_ERRORS":          7,
+		},
+		ts: ts,
+	}
+	cur := &counterSample{
+		values: map[string]uint64{
+			// 1000 octets in 2 seconds
+			"SAI_PORT_STAT_IF_IN_OCTETS": 3000,
+			// Wrapped after 200 octets
+			"SAI_PORT_STAT_IF_OUT_OCTETS": 100,
+			// Cleared, then 40 packets
+			"SAI_PORT_STAT_IF_IN_UCAST_PKTS":     30,
+			"SAI_PORT_STAT_IF_IN_NON_UCAST_PKTS": 20,
+			// One of the counters of TX_PPS missing
+			"SAI_PORT_STAT_IF_OUT_UCAST_PKTS": 300,
+			// Missing in prev
+			"SAI_PORT_STAT_IF_OUT_ERRORS": 3,
+			"SAI_PORT_STAT_IF_IN_ERRORS":  7,
+		},
+		ts: ts.Add(2 * time.Second),
+	}
+	want := map[string]string{
+		"RX_BPS":     "8000.00",
+		"TX_BPS":     "800.00",
+		"RX_PPS":     "20.00",
+		"RX_ERR_PPS": "0.00",
+	}
+
+	got := computeRates(prev, cur)
+	if len(got) != len(want) {
+		t.Errorf("computeRates = %v, want %v", got, want)
+	}
+	for name, rate := range want {
+		if got[name] != rate {
+			t.Errorf("%v = %q, want %q", name, got[name], rate)
+		}
+	}
+
+	if got := computeRates(cur, cur); len(got) != 0 {
+		t.Errorf("computeRates of samples at the same time = %v, want none", got)
+	}
+}
@@ -3,6 +3,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -14,10 +15,13 @@ import (
 
 	log "github.com/golang/glog"
 
+	"common_utils"
 	spb "proto"
+	sdcfg "sonic_db_config"
 	"github.com/go-redis/redis"
 	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/Workiva/go-datastructures/queue"
+	"google.golang.org/grpc/codes"
 )
 
 const (
@@ -45,8 +49,9 @@ type Client interface {
 	OnceRun(q *queue.PriorityQueue, once chan struct{}, w *sync.WaitGroup, subscribe *gnmipb.SubscriptionList)
 	// Get return data from the data source in format of *spb.Value
 	Get(w *sync.WaitGroup) ([]*spb.Value, error)
-	// Set data based on path and value
-	Set(path *gnmipb.Path,  t *gnmipb.TypedValue, op int) error
+	// Set deletes paths of delete, then replaces and updates values, all
+	// at once
+	Set(delete []*gnmipb.Path, replace []*gnmipb.Update, update []*gnmipb.Update) error
 	// Capabilities of the switch
 	Capabilities() ([]gnmipb.ModelData)
 
@@ -54,6 +59,12 @@ type Client interface {
 	Close() error
 }
 
+// PartialUpdater is implemented by clients whose values streamed after
+// the initial sync may carry only the changed part of the value of a path.
+type PartialUpdater interface {
+	PartialUpdates() bool
+}
+
 type Stream interface {
 	Send(m *gnmipb.SubscribeResponse) error
 }
@@ -62,12 +73,22 @@ type Stream interface {
 // May add an interface function for it.
 var UseRedisLocalTcpPort bool = false
 
-// redis client connected to each DB
-var Target2RedisDb = make(map[string]*redis.Client)
+// redis client connected to each DB, per namespace
+var Target2RedisDb = make(map[string]map[string]*redis.Client)
+
+// Whether redis clients in Target2RedisDb use tcp connection
+var redisTcpInUse bool = false
+
+// Name of host namespace in json data merged from all namespaces
+const hostNamespaceJsonName = "host"
+
+// Name of member data in json data of aggregated object like PortChannel
+const jsonMembersName = "Members"
 
 type tablePath struct {
-	dbName    string
-	tableName string
+	dbNamespace string
+	dbName      string
+	tableName   string
 	tableKey  string
 	delimitor string
 	field     string
@@ -75,10 +96,26 @@ type tablePath struct {
 	// from the real data path. Ex. in Counters table, real tableKey
 	// is oid:0x####, while key name like Ethernet## may be put
 	// in json data. They are to be filled in populateDbtablePath()
+	// jsonTableName, if set, names data of the table under jsonTableKey,
+	// ex. watermarks of a queue from several tables.
 	jsonTableName string
 	jsonTableKey  string
 	jsonDelimitor string
 	jsonField     string
+	// Group of jsonTableKey in json data, ex. "Queues" of a port
+	jsonGroup string
+	// For path on all namespaces, data of each namespace is put
+	// under its name in json data.
+	jsonNamespace string
+	// Data is of a member of jsonTableKey, ex. a port of PortChannel.
+	// It is put under "Members" of jsonTableKey, whose own data is the
+	// sum of all its members.
+	jsonMemberKey string
+	// Data is rates of counters computed from consecutive samples,
+	// see counter_rates.go
+	rates bool
+	// Counters are relative to the baseline, see counter_baseline.go
+	baseline *counterBaseline
 }
 
 type Value struct {
@@ -97,11 +134,24 @@ func (val Value) Compare(other queue.Item) int {
 }
 
 type DbClient struct {
-	prefix  *gnmipb.Path
+	prefix *gnmipb.Path
+	// Paths in request order
+	paths   []*gnmipb.Path
 	pathG2S map[*gnmipb.Path][]tablePath
 	q       *queue.PriorityQueue
 	channel chan struct{}
 
+	// Generation of virtual path name maps used to populate pathG2S
+	v2rGen uint64
+	// Stop channel of subscribe routines of each path in stream mode
+	pathStop map[*gnmipb.Path]chan struct{}
+	// Sample interval of each path in stream mode
+	pathInterval map[*gnmipb.Path]time.Duration
+	// Last counter samples of paths for rates
+	rateSamples map[tablePath]*counterSample
+	// User owning counter baselines asked by paths
+	user string
+
 	synced sync.WaitGroup  // Control when to send gNMI sync_response
 	w      *sync.WaitGroup // wait for all sub go routines to finish
 	mu     sync.RWMutex    // Mutex for data protection among routines for DbClient
@@ -111,7 +161,7 @@ type DbClient struct {
 	errors  int64
 }
 
-func NewDbClient(paths []*gnmipb.Path, prefix *gnmipb.Path) (Client, error) {
+func NewDbClient(paths []*gnmipb.Path, prefix *gnmipb.Path, ctx context.Context) (Client, error) {
 	var client DbClient
 	var err error
 
@@ -119,34 +169,24 @@ func NewDbClient(paths []*gnmipb.Path, prefix *gnmipb.Path) (Client, error) {
 	if UseRedisLocalTcpPort {
 		useRedisTcpClient()
 	}
-	if prefix.GetTarget() == "COUNTERS_DB" {
-		err = initCountersPortNameMap()
-		if err != nil {
-			return nil, err
-		}
-		err = initCountersQueueNameMap()
-		if err != nil {
-			return nil, err
-		}
-		err = initAliasMap()
-		if err != nil {
-			return nil, err
-		}
-		err = initCountersPfcwdNameMap()
-		if err != nil {
-			return nil, err
-		}
-	}
 
 	client.prefix = prefix
+	client.paths = paths
 	client.pathG2S = make(map[*gnmipb.Path][]tablePath)
+	client.v2rGen = getNameMapGen()
+	client.rateSamples = make(map[tablePath]*counterSample)
+	rc, _ := common_utils.GetContext(ctx)
+	client.user = rc.Auth.User
 	err = populateAllDbtablePath(prefix, paths, &client.pathG2S)
-
 	if err != nil {
 		return nil, err
-	} else {
-		return &client, nil
 	}
+	for gnmiPath, tblPaths := range client.pathG2S {
+		if err = client.setBaseline(gnmiPath, tblPaths); err != nil {
+			return nil, err
+		}
+	}
+	return &client, nil
 }
 
 // String returns the target the client is querying.
@@ -162,21 +202,13 @@ func (c *DbClient) StreamRun(q *queue.PriorityQueue, stop chan struct{}, w *sync
 	c.q = q
 	c.channel = stop
 
+	c.pathStop = make(map[*gnmipb.Path]chan struct{})
+	c.pathInterval = make(map[*gnmipb.Path]time.Duration)
+	for _, sub := range subscribe.GetSubscription() {
+		c.pathInterval[sub.GetPath()] = time.Duration(sub.GetSampleInterval())
+	}
 	for gnmiPath, tblPaths := range c.pathG2S {
-		if tblPaths[0].field != "" {
-			c.w.Add(1)
-			c.synced.Add(1)
-			if len(tblPaths) > 1 {
-				go dbFieldMultiSubscribe(gnmiPath, c)
-			} else {
-				go dbFieldSubscribe(gnmiPath, c)
-			}
-			continue
-		}
-		c.w.Add(1)
-		c.synced.Add(1)
-		go dbTableKeySubscribe(gnmiPath, c)
-		continue
+		c.startPathSubscribe(gnmiPath, tblPaths)
 	}
 
 	// Wait until all data values corresponding to the path(s) specified
@@ -194,6 +226,11 @@ func (c *DbClient) StreamRun(q *queue.PriorityQueue, stop chan struct{}, w *sync
 		select {
 		default:
 			time.Sleep(time.Second)
+			// Restart subscription on paths whose virtual path translation changed
+			for _, gnmiPath := range c.refreshPaths() {
+				close(c.pathStop[gnmiPath])
+				c.startPathSubscribe(gnmiPath, c.pathG2S[gnmiPath])
+			}
 		case <-c.channel:
 			log.V(1).Infof("Exiting StreamRun routine for Client %v", c.pathG2S)
 			return
@@ -201,6 +238,100 @@ func (c *DbClient) StreamRun(q *queue.PriorityQueue, stop chan struct{}, w *sync
 	}
 }
 
+// startPathSubscribe starts routine to watch data change of gnmiPath.
+func (c *DbClient) startPathSubscribe(gnmiPath *gnmipb.Path, tblPaths []tablePath) {
+	stop := make(chan struct{})
+	c.pathStop[gnmiPath] = stop
+	c.w.Add(1)
+	c.synced.Add(1)
+	if isRatesPath(tblPaths) {
+		// Rates are sampled whatever the subscription mode is
+		go dbRatesSubscribe(gnmiPath, tblPaths, c.pathInterval[gnmiPath], stop, c)
+		return
+	}
+	if isFieldPath(tblPaths) {
+		if len(tblPaths) > 1 || tblPaths[0].jsonNamespace != "" || tblPaths[0].jsonMemberKey != "" {
+			go dbFieldMultiSubscribe(gnmiPath, tblPaths, stop, c)
+		} else {
+			go dbFieldSubscribe(gnmiPath, tblPaths, stop, c)
+		}
+		return
+	}
+	go dbTableKeySubscribe(gnmiPath, tblPaths, stop, c)
+}
+
+// isFieldPath tells whether tblPaths are for fields of a table key
+func isFieldPath(tblPaths []tablePath) bool {
+	return len(tblPaths) > 0 && tblPaths[0].field != ""
+}
+
+// PartialUpdates tells whether the client streams changes of tables or
+// table keys, whose values after the initial one hold the changed keys or
+// fields only.
+func (c *DbClient) PartialUpdates() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, tblPaths := range c.pathG2S {
+		if !isRatesPath(tblPaths) && !isFieldPath(tblPaths) {
+			return true
+		}
+	}
+	return false
+}
+
+// refreshPaths translates paths again if virtual path name maps have been
+// refreshed since pathG2S was populated. Paths whose table paths changed
+// are returned.
+func (c *DbClient) refreshPaths() []*gnmipb.Path {
+	gen := getNameMapGen()
+	if gen == c.v2rGen {
+		return nil
+	}
+	c.v2rGen = gen
+
+	var changed []*gnmipb.Path
+	for gnmiPath, tblPaths := range c.pathG2S {
+		pathG2S := make(map[*gnmipb.Path][]tablePath)
+		if err := populateDbtablePaths(c.prefix, gnmiPath, &pathG2S); err != nil {
+			// Keep the old translation, ex. the port queried has been removed
+			log.V(1).Infof("Failed to refresh %v: %v", gnmiPath, err)
+			continue
+		}
+		if err := c.setBaseline(gnmiPath, pathG2S[gnmiPath]); err != nil {
+			// ex. the baseline has been deleted
+			log.V(1).Infof("Failed to refresh %v: %v", gnmiPath, err)
+			continue
+		}
+		if sameTablePaths(tblPaths, pathG2S[gnmiPath]) {
+			continue
+		}
+		log.V(2).Infof("Refreshed %v from %v to %v", gnmiPath, tblPaths, pathG2S[gnmiPath])
+		c.mu.Lock()
+		c.pathG2S[gnmiPath] = pathG2S[gnmiPath]
+		c.mu.Unlock()
+		changed = append(changed, gnmiPath)
+	}
+	return changed
+}
+
+// sameTablePaths tells whether a and b have the same set of table paths
+func sameTablePaths(a, b []tablePath) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := make(map[tablePath]int)
+	for _, tblPath := range a {
+		count[tblPath]++
+	}
+	for _, tblPath := range b {
+		if count[tblPath] == 0 {
+			return false
+		}
+		count[tblPath]--
+	}
+	return true
+}
+
 func (c *DbClient) PollRun(q *queue.PriorityQueue, poll chan struct{}, w *sync.WaitGroup, subscribe *gnmipb.SubscriptionList) {
 	c.w = w
 	defer c.w.Done()
@@ -214,20 +345,23 @@ func (c *DbClient) PollRun(q *queue.PriorityQueue, poll chan struct{}, w *sync.W
 			return
 		}
 		t1 := time.Now()
+		c.refreshPaths()
+		// All paths are sent in one value of the poll time
+		spbv := &spb.Value{
+			Prefix:    c.prefix,
+			Timestamp: t1.UnixNano(),
+		}
 		for gnmiPath, tblPaths := range c.pathG2S {
-			val, err := tableData2TypedValue(tblPaths, nil)
+			val, err := c.pathTypedValue(tblPaths)
 			if err != nil {
 				return
 			}
-
-			spbv := &spb.Value{
-				Prefix:       c.prefix,
-				Path:         gnmiPath,
-				Timestamp:    time.Now().UnixNano(),
-				SyncResponse: false,
-				Val:          val,
-			}
-
+			spbv.Updates = append(spbv.Updates, &gnmipb.Update{
+				Path: gnmiPath,
+				Val:  val,
+			})
+		}
+		if len(spbv.Updates) != 0 {
 			c.q.Put(Value{spbv})
 			log.V(6).Infof("Added spbv #%v", spbv)
 		}
@@ -250,17 +384,18 @@ func (c *DbClient) Get(w *sync.WaitGroup) ([]*spb.Value, error) {
 
 	var values []*spb.Value
 	ts := time.Now()
-	for gnmiPath, tblPaths := range c.pathG2S {
-		val, err := tableData2TypedValue(tblPaths, nil)
-		if err != nil {
-			return nil, err
-		}
-
+	vals, err := getPaths(c.paths, func(gnmiPath *gnmipb.Path) (*gnmipb.TypedValue, error) {
+		return c.pathTypedValue(c.pathG2S[gnmiPath])
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, gnmiPath := range c.paths {
 		values = append(values, &spb.Value{
 			Prefix:    c.prefix,
 			Path:      gnmiPath,
 			Timestamp: ts.UnixNano(),
-			Val:       val,
+			Val:       vals[i],
 		})
 	}
 	log.V(6).Infof("Getting #%v", values)
@@ -294,74 +429,105 @@ func ValToResp(val Value) (*gnmipb.SubscribeResponse, error) {
 				Update: &gnmipb.Notification{
 					Timestamp: val.GetTimestamp(),
 					Prefix:    val.GetPrefix(),
-					Update: []*gnmipb.Update{
-						{
-							Path: val.GetPath(),
-							Val:  val.GetVal(),
-						},
-					},
+					Update:    ValueUpdates(val.Value),
+					Delete:    val.GetDeletes(),
 				},
 			},
 		}, nil
 	}
 }
 
-func GetTableKeySeparator(target string) (string, error) {
-	_, ok := spb.Target_value[target]
-	if !ok {
-		log.V(1).Infof(" %v not a valid path target", target)
-		return "", fmt.Errorf("%v not a valid path target", target)
+// ValueUpdates returns the update of path and val of v if any, followed by
+// its other updates
+func ValueUpdates(v *spb.Value) []*gnmipb.Update {
+	var updates []*gnmipb.Update
+	if v.GetPath() != nil || v.GetVal() != nil {
+		updates = append(updates, &gnmipb.Update{
+			Path: v.GetPath(),
+			Val:  v.GetVal(),
+		})
 	}
+	return append(updates, v.GetUpdates()...)
+}
 
-	var separator string
-	switch target {
-	case "CONFIG_DB":
-		separator = "|"
-	case "STATE_DB":
-		separator = "|"
-	default:
-		separator = ":"
+func GetTableKeySeparator(target string, ns string) (string, error) {
+	separator, err := sdcfg.GetDbSeparator(target, ns)
+	if err != nil {
+		log.V(1).Infof(" %v not a valid path target in namespace %q", target, ns)
+		return "", fmt.Errorf("%v not a valid path target in namespace %q", target, ns)
 	}
 	return separator, nil
 }
 
-// For testing only
-func useRedisTcpClient() {
-	for dbName, dbn := range spb.Target_value {
-		if dbName != "OTHERS" {
-			// DB connector for direct redis operation
-			var redisDb *redis.Client
-			if UseRedisLocalTcpPort {
-				redisDb = redis.NewClient(&redis.Options{
-					Network:     "tcp",
-					Addr:        Default_REDIS_LOCAL_TCP_PORT,
-					Password:    "", // no password set
-					DB:          int(dbn),
-					DialTimeout: 0,
-				})
+// IsTargetDb tells whether target is a redis database served by DbClient
+func IsTargetDb(target string) bool {
+	return sdcfg.IsDb(target)
+}
+
+// newRedisClient returns a redis client connected to the instance serving dbName in namespace ns
+func newRedisClient(dbName, ns string) (*redis.Client, error) {
+	dbn, err := sdcfg.GetDbId(dbName, ns)
+	if err != nil {
+		return nil, err
+	}
+	var network, addr string
+	if UseRedisLocalTcpPort {
+		network = "tcp"
+		addr, err = sdcfg.GetDbTcpAddr(dbName, ns)
+	} else {
+		network = "unix"
+		addr, err = sdcfg.GetDbSock(dbName, ns)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return redis.NewClient(&redis.Options{
+		Network:     network,
+		Addr:        addr,
+		Password:    "", // no password set
+		DB:          dbn,
+		DialTimeout: 0,
+	}), nil
+}
+
+// Prepare redis clients to all DBs of all namespaces described in database config
+func initRedisDbClients() {
+	for _, ns := range sdcfg.GetDbNamespaces() {
+		clients := make(map[string]*redis.Client)
+		for _, dbName := range sdcfg.GetDbList(ns) {
+			redisDb, err := newRedisClient(dbName, ns)
+			if err != nil {
+				log.V(1).Infof("Failed to create redis client for %v in namespace %q: %v", dbName, ns, err)
+				continue
 			}
-			Target2RedisDb[dbName] = redisDb
+			clients[dbName] = redisDb
 		}
+		Target2RedisDb[ns] = clients
+	}
+	redisTcpInUse = UseRedisLocalTcpPort
+}
+
+// LoadDbConfig switches to the database layout described in file at path,
+// and reconnects redis clients accordingly.
+func LoadDbConfig(path string) error {
+	if err := sdcfg.SetConfigFile(path); err != nil {
+		return err
+	}
+	Target2RedisDb = make(map[string]map[string]*redis.Client)
+	initRedisDbClients()
+	return nil
+}
+
+// For testing only
+func useRedisTcpClient() {
+	if !redisTcpInUse {
+		initRedisDbClients()
 	}
 }
 
 // Client package prepare redis clients to all DBs automatically
 func init() {
-	for dbName, dbn := range spb.Target_value {
-		if dbName != "OTHERS" {
-			// DB connector for direct redis operation
-			var redisDb *redis.Client
-
-			redisDb = redis.NewClient(&redis.Options{
-				Network:     "unix",
-				Addr:        Default_REDIS_UNIXSOCKET,
-				Password:    "", // no password set
-				DB:          int(dbn),
-				DialTimeout: 0,
-			})
-			Target2RedisDb[dbName] = redisDb
-		}
-	}
+	initRedisDbClients()
 }
 
 // gnmiFullPath builds the full path from the prefix and path.
@@ -377,9 +543,27 @@ func gnmiFullPath(prefix, path *gnmipb.Path) *gnmipb.Path {
 	return fullPath
 }
 
+// getPathNamespaces returns the namespaces a gnmi path is querying.
+// Namespace is specified with origin of path or prefix, host namespace
+// is used if not specified. Origin "*" is for all namespaces, whose
+// data is merged into one json value keyed by namespace name.
+func getPathNamespaces(prefix, path *gnmipb.Path) ([]string, bool, error) {
+	ns := path.GetOrigin()
+	if ns == "" {
+		ns = prefix.GetOrigin()
+	}
+	if ns == "*" {
+		return sdcfg.GetDbNamespaces(), true, nil
+	}
+	if !sdcfg.IsNamespace(ns) {
+		return nil, false, errorf(codes.InvalidArgument, "Invalid namespace %v", ns)
+	}
+	return []string{ns}, false, nil
+}
+
 func populateAllDbtablePath(prefix *gnmipb.Path, paths []*gnmipb.Path, pathG2S *map[*gnmipb.Path][]tablePath) error {
 	for _, path := range paths {
-		err := populateDbtablePath(prefix, path, pathG2S)
+		err := populateDbtablePaths(prefix, path, pathG2S)
 		if err != nil {
 			return err
 		}
@@ -387,17 +571,52 @@ func populateAllDbtablePath(prefix *gnmipb.Path, paths []*gnmipb.Path, pathG2S *
 	return nil
 }
 
-// Populate table path in DB from gnmi path
-func populateDbtablePath(prefix, path *gnmipb.Path, pathG2S *map[*gnmipb.Path][]tablePath) error {
+// Populate table paths in DB of all namespaces queried by gnmi path
+func populateDbtablePaths(prefix, path *gnmipb.Path, pathG2S *map[*gnmipb.Path][]tablePath) error {
+	namespaces, allNs, err := getPathNamespaces(prefix, path)
+	if err != nil {
+		return err
+	}
+	for _, ns := range namespaces {
+		err = populateDbtablePath(prefix, path, ns, allNs, pathG2S)
+		if err != nil && !allNs {
+			return err
+		}
+		if err != nil {
+			// Data may exist in some of the namespaces only
+			log.V(2).Infof("Skip namespace %q for %v: %v", ns, path, err)
+		}
+	}
+	if _, ok := (*pathG2S)[path]; !ok {
+		return fmt.Errorf("%v not found in any namespace: %v", path, err)
+	}
+	return nil
+}
+
+// Populate table path in DB of namespace ns from gnmi path
+func populateDbtablePath(prefix, path *gnmipb.Path, ns string, allNs bool, pathG2S *map[*gnmipb.Path][]tablePath) error {
 	var buffer bytes.Buffer
 	var dbPath string
 	var tblPath tablePath
 
 	target := prefix.GetTarget()
 	// Verify it is a valid db name
-	redisDb, ok := Target2RedisDb[target]
+	redisDb, ok := Target2RedisDb[ns][target]
 	if !ok {
-		return fmt.Errorf("Invalid target name %v", target)
+		return errorf(codes.InvalidArgument, "Invalid target name %v", target)
+	}
+	var jsonNamespace string
+	if allNs {
+		jsonNamespace = ns
+		if ns == sdcfg.SONIC_DEFAULT_NAMESPACE {
+			jsonNamespace = hostNamespaceJsonName
+		}
+	}
+
+	if target == "COUNTERS_DB" {
+		if err := initCountersNameMap(ns); err != nil {
+			return err
+		}
 	}
 
 	fullPath := path
@@ -406,7 +625,7 @@ func populateDbtablePath(prefix, path *gnmipb.Path, pathG2S *map[*gnmipb.Path][]
 	}
 
 	stringSlice := []string{target}
-	separator, _ := GetTableKeySeparator(target)
+	separator, _ := GetTableKeySeparator(target, ns)
 	elems := fullPath.GetElem()
 	if elems != nil {
 		for i, elem := range elems {
@@ -423,14 +642,20 @@ func populateDbtablePath(prefix, path *gnmipb.Path, pathG2S *map[*gnmipb.Path][]
 
 	// First lookup the Virtual path to Real path mapping tree
 	// The path from gNMI might not be real db path
-	if tblPaths, err := lookupV2R(stringSlice); err == nil {
-		(*pathG2S)[path] = tblPaths
-		log.V(5).Infof("v2r from %v to %+v ", stringSlice, tblPaths)
+	if tblPaths, err := lookupV2R(stringSlice, ns); err == nil {
+		for i := range tblPaths {
+			tblPaths[i].dbNamespace = ns
+			tblPaths[i].jsonNamespace = jsonNamespace
+		}
+		(*pathG2S)[path] = append((*pathG2S)[path], tblPaths...)
+		log.V(5).Infof("v2r from %v in namespace %q to %+v ", stringSlice, ns, tblPaths)
 		return nil
 	} else {
 		log.V(5).Infof("v2r lookup failed for %v %v", stringSlice, err)
 	}
 
+	tblPath.dbNamespace = ns
+	tblPath.jsonNamespace = jsonNamespace
 	tblPath.dbName = target
 	tblPath.tableName = stringSlice[1]
 	tblPath.delimitor = separator
@@ -457,7 +682,7 @@ func populateDbtablePath(prefix, path *gnmipb.Path, pathG2S *map[*gnmipb.Path][]
 	case 3: // Third element could be table key; or field name in which case table name itself is the key too
 		n, err := redisDb.Exists(tblPath.tableName + tblPath.delimitor + mappedKey).Result()
 		if err != nil {
-			return fmt.Errorf("redis Exists op failed for %v", dbPath)
+			return errorf(codes.Unavailable, "redis Exists op failed for %v", dbPath)
 		}
 		if n == 1 {
 			tblPath.tableKey = mappedKey
@@ -470,7 +695,7 @@ func populateDbtablePath(prefix, path *gnmipb.Path, pathG2S *map[*gnmipb.Path][]
 		key := tblPath.tableName + tblPath.delimitor + tblPath.tableKey
 		n, err := redisDb.Exists(key).Result()
 		if err != nil {
-			return fmt.Errorf("redis Exists op failed for %v", dbPath)
+			return errorf(codes.Unavailable, "redis Exists op failed for %v", dbPath)
 		}
 		if n != 1 { // Looks like the Fourth slice is not part of the key
 			tblPath.tableKey = mappedKey
@@ -481,7 +706,7 @@ func populateDbtablePath(prefix, path *gnmipb.Path, pathG2S *map[*gnmipb.Path][]
 		tblPath.field = stringSlice[4]
 	default:
 		log.V(2).Infof("Invalid db table Path %v", dbPath)
-		return fmt.Errorf("Invalid db table Path %v", dbPath)
+		return errorf(codes.InvalidArgument, "Invalid db table Path %v", dbPath)
 	}
 
 	var key string
@@ -494,7 +719,7 @@ func populateDbtablePath(prefix, path *gnmipb.Path, pathG2S *map[*gnmipb.Path][]
 		}
 	}
 
-	(*pathG2S)[path] = []tablePath{tblPath}
+	(*pathG2S)[path] = append((*pathG2S)[path], tblPath)
 	log.V(5).Infof("tablePath %+v", tblPath)
 	return nil
 }
@@ -527,6 +752,141 @@ func makeJSON_redis(msi *map[string]interface{}, key *string, op *string, mfv ma
 	return nil
 }
 
+// nsMsi returns the map in msi to hold data of namespace jsonNamespace,
+// which is msi itself if namespace is not asked to be in json data.
+func nsMsi(msi map[string]interface{}, jsonNamespace string) map[string]interface{} {
+	if jsonNamespace == "" {
+		return msi
+	}
+	nsm, ok := msi[jsonNamespace].(map[string]interface{})
+	if !ok {
+		nsm = make(map[string]interface{})
+		msi[jsonNamespace] = nsm
+	}
+	return nsm
+}
+
+// findAggregateMsi returns the map in msi holding data of the object
+// which tblPath is a member of.
+func findAggregateMsi(msi map[string]interface{}, tblPath *tablePath, create bool) (map[string]interface{}, bool) {
+	return nestedMsi(msi, create, tblPath.jsonNamespace, tblPath.jsonTableKey)
+}
+
+// nestedMsi returns the map in msi under keys in order, empty keys are
+// skipped. Missing maps are added if create is true.
+func nestedMsi(msi map[string]interface{}, create bool, keys ...string) (map[string]interface{}, bool) {
+	m := msi
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		sub, ok := m[key].(map[string]interface{})
+		if !ok {
+			if !create {
+				return nil, false
+			}
+			sub = make(map[string]interface{})
+			m[key] = sub
+		}
+		m = sub
+	}
+	return m, true
+}
+
+// isMsiContainer tells whether all values in msi are maps, that is msi
+// holds objects rather than field value pairs.
+func isMsiContainer(msi map[string]interface{}) bool {
+	if len(msi) == 0 {
+		return false
+	}
+	for _, v := range msi {
+		if _, ok := v.(map[string]interface{}); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeMsi merges data in src into dst. Objects are merged recursively
+// while field value pairs replace the old ones. src is not referred by
+// containers in dst, so that later merges don't change it.
+func mergeMsi(dst, src map[string]interface{}) {
+	for k, v := range src {
+		sm, ok := v.(map[string]interface{})
+		if !ok || !isMsiContainer(sm) {
+			dst[k] = v
+			continue
+		}
+		dm, ok := dst[k].(map[string]interface{})
+		if !ok || !isMsiContainer(dm) {
+			dm = make(map[string]interface{})
+			dst[k] = dm
+		}
+		mergeMsi(dm, sm)
+	}
+}
+
+// putMemberData puts fv of a member into data of its aggregated object in msi
+func putMemberData(msi map[string]interface{}, tblPath *tablePath, fv map[string]string) {
+	agg, _ := findAggregateMsi(msi, tblPath, true)
+	members, ok := agg[jsonMembersName].(map[string]interface{})
+	if !ok {
+		members = make(map[string]interface{})
+		agg[jsonMembersName] = members
+	}
+	fp := map[string]interface{}{}
+	for f, v := range fv {
+		fp[f] = v
+	}
+	members[tblPath.jsonMemberKey] = fp
+}
+
+// aggregateMemberData makes sure aggregated objects in msi have data of
+// all their members, missing ones are added by fill, then sets each
+// counter of the object to the sum of its members.
+func aggregateMemberData(msi map[string]interface{}, tblPaths []tablePath, fill func(*tablePath) error) error {
+	aggs := make(map[[2]string]map[string]interface{})
+	for i := range tblPaths {
+		tblPath := &tblPaths[i]
+		if tblPath.jsonMemberKey == "" {
+			continue
+		}
+		agg, ok := findAggregateMsi(msi, tblPath, false)
+		if !ok {
+			// No change in this object
+			continue
+		}
+		aggs[[2]string{tblPath.jsonNamespace, tblPath.jsonTableKey}] = agg
+		members, _ := agg[jsonMembersName].(map[string]interface{})
+		if _, ok := members[tblPath.jsonMemberKey]; !ok {
+			if err := fill(tblPath); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, agg := range aggs {
+		members, _ := agg[jsonMembersName].(map[string]interface{})
+		sums := make(map[string]uint64)
+		for _, data := range members {
+			fp, _ := data.(map[string]interface{})
+			for f, v := range fp {
+				sv, _ := v.(string)
+				n, err := strconv.ParseUint(sv, 10, 64)
+				if err != nil {
+					// Not a counter
+					continue
+				}
+				sums[f] += n
+			}
+		}
+		for f, n := range sums {
+			agg[f] = strconv.FormatUint(n, 10)
+		}
+	}
+	return nil
+}
+
 // emitJSON marshalls map[string]interface{} to JSON byte stream.
 func emitJSON(v *map[string]interface{}) ([]byte, error) {
 	//j, err := json.MarshalIndent(*v, "", indentString)
@@ -538,39 +898,93 @@ func emitJSON(v *map[string]interface{}) ([]byte, error) {
 	return j, nil
 }
 
+// tablePathKeys returns redis keys of tblPath. If only table name provided
+// in the tablePath, all keys in the table are returned.
+func tablePathKeys(tblPath *tablePath) ([]string, error) {
+	if tblPath.tableKey != "" {
+		// both table name and key provided
+		return []string{tblPath.tableName + tblPath.delimitor + tblPath.tableKey}, nil
+	}
+
+	var pattern string
+	// tables in COUNTERS_DB other than COUNTERS table doesn't have keys
+	if tblPath.dbName == "COUNTERS_DB" && tblPath.tableName != "COUNTERS" {
+		pattern = tblPath.tableName
+	} else {
+		pattern = tblPath.tableName + tblPath.delimitor + "*"
+	}
+	redisDb := Target2RedisDb[tblPath.dbNamespace][tblPath.dbName]
+	dbkeys, err := redisDb.Keys(pattern).Result()
+	if err != nil {
+		log.V(2).Infof("redis Keys failed for %v, pattern %s", tblPath, pattern)
+		return nil, fmt.Errorf("redis Keys failed for %v, pattern %s %v", tblPath, pattern, err)
+	}
+	return dbkeys, nil
+}
+
 // tableData2Msi renders the redis DB data to map[string]interface{}
 // which may be marshaled to JSON format
 // If only table name provided in the tablePath, find all keys in the table, otherwise
 // Use tableName + tableKey as key to get all field value paires
 func tableData2Msi(tblPath *tablePath, useKey bool, op *string, msi *map[string]interface{}) error {
-	redisDb := Target2RedisDb[tblPath.dbName]
+	// Data of member goes to its aggregated object
+	if tblPath.jsonMemberKey != "" {
+		key := tblPath.tableName + tblPath.delimitor + tblPath.tableKey
+		if tblPath.field != "" {
+			val, err := hget(tblPath, key, tblPath.field)
+			if err != nil {
+				log.V(3).Infof("redis HGet failed for %v %v", tblPath, err)
+				// ignore non-existing field which was derived from virtual path
+				return nil
+			}
+			putMemberData(*msi, tblPath, map[string]string{tblPath.jsonField: val})
+			return nil
+		}
+		fv, err := hgetAll(tblPath, key)
+		if err != nil {
+			log.V(2).Infof("redis HGetAll failed for %v, dbkey %s", tblPath, key)
+			return err
+		}
+		putMemberData(*msi, tblPath, fv)
+		return nil
+	}
 
-	var pattern string
-	var dbkeys []string
-	var err error
-	var fv map[string]string
+	if tblPath.jsonNamespace != "" {
+		nsm := nsMsi(*msi, tblPath.jsonNamespace)
+		msi = &nsm
+	}
 
-	//Only table name provided
-	if tblPath.tableKey == "" {
-		// tables in COUNTERS_DB other than COUNTERS table doesn't have keys
-		if tblPath.dbName == "COUNTERS_DB" && tblPath.tableName != "COUNTERS" {
-			pattern = tblPath.tableName
-		} else {
-			pattern = tblPath.tableName + tblPath.delimitor + "*"
-		}
-		dbkeys, err = redisDb.Keys(pattern).Result()
+	// Data of the table is put under its name in the object
+	if tblPath.jsonTableName != "" {
+		key := tblPath.tableName + tblPath.delimitor + tblPath.tableKey
+		fv, err := hgetAll(tblPath, key)
 		if err != nil {
-			log.V(2).Infof("redis Keys failed for %v, pattern %s", tblPath, pattern)
-			return fmt.Errorf("redis Keys failed for %v, pattern %s %v", tblPath, pattern, err)
+			log.V(2).Infof("redis HGetAll failed for %v, dbkey %s", tblPath, key)
+			return err
 		}
-	} else {
-		// both table name and key provided
-		dbkeys = []string{tblPath.tableName + tblPath.delimitor + tblPath.tableKey}
+		if len(fv) == 0 {
+			// ex. watermark not collected yet
+			return nil
+		}
+		obj, _ := nestedMsi(*msi, true, tblPath.jsonGroup, tblPath.jsonTableKey)
+		return makeJSON_redis(&obj, &tblPath.jsonTableName, op, fv)
+	}
+
+	if tblPath.jsonGroup != "" {
+		gm, _ := nestedMsi(*msi, true, tblPath.jsonGroup)
+		msi = &gm
+	}
+
+	var fv map[string]string
+
+	dbkeys, err := tablePathKeys(tblPath)
+	if err != nil {
+		return err
 	}
 
 	// Asked to use jsonField and jsonTableKey in the final json value
 	if tblPath.jsonField != "" && tblPath.jsonTableKey != "" {
-		val, err := redisDb.HGet(dbkeys[0], tblPath.field).Result()
+		val, err := hget(tblPath, dbkeys[0], tblPath.field)
 		if err != nil {
 			log.V(3).Infof("redis HGet failed for %v %v", tblPath, err)
 			// ignore non-existing field which was derived from virtual path
@@ -583,7 +997,7 @@ func tableData2Msi(tblPath *tablePath, useKey bool, op *string, msi *map[string]
 	}
 
 	for idx, dbkey := range dbkeys {
-		fv, err = redisDb.HGetAll(dbkey).Result()
+		fv, err = hgetAll(tblPath, dbkey)
 		if err != nil {
 			log.V(2).Infof("redis HGetAll failed for  %v, dbkey %s", tblPath, dbkey)
 			return err
@@ -625,12 +1039,10 @@ func tableData2TypedValue(tblPaths []tablePath, op *string) (*gnmipb.TypedValue,
 	var useKey bool
 	msi := make(map[string]interface{})
 	for _, tblPath := range tblPaths {
-		redisDb := Target2RedisDb[tblPath.dbName]
-
 		if tblPath.jsonField == "" { // Not asked to include field in json value, which means not wildcard query
 			// table path includes table, key and field
 			if tblPath.field != "" {
-				if len(tblPaths) != 1 {
+				if len(tblPaths) != 1 && tblPath.jsonNamespace == "" {
 					log.V(2).Infof("WARNING: more than one path exists for field granularity query: %v", tblPaths)
 				}
 				var key string
@@ -640,11 +1052,15 @@ func tableData2TypedValue(tblPaths []tablePath, op *string) (*gnmipb.TypedValue,
 					key = tblPath.tableName
 				}
 
-				val, err := redisDb.HGet(key, tblPath.field).Result()
+				val, err := hget(&tblPath, key, tblPath.field)
 				if err != nil {
 					log.V(2).Infof("redis HGet failed for %v", tblPath)
 					return nil, err
 				}
+				if tblPath.jsonNamespace != "" {
+					msi[tblPath.jsonNamespace] = val
+					continue
+				}
 				// TODO: support multiple table paths
 				return &gnmipb.TypedValue{
 					Value: &gnmipb.TypedValue_StringVal{
@@ -658,6 +1074,12 @@ func tableData2TypedValue(tblPaths []tablePath, op *string) (*gnmipb.TypedValue,
 			return nil, err
 		}
 	}
+	err := aggregateMemberData(msi, tblPaths, func(tblPath *tablePath) error {
+		return tableData2Msi(tblPath, useKey, nil, &msi)
+	})
+	if err != nil {
+		return nil, err
+	}
 	return msi2TypedValue(msi)
 }
 
@@ -670,17 +1092,29 @@ func enqueFatalMsg(c *DbClient, msg string) {
 	})
 }
 
-// for subscribe request with granularity of table field, the value is fetched periodically.
+// fieldTableKey returns the redis key of tblPath with field granularity
+func fieldTableKey(tblPath *tablePath) string {
+	if tblPath.tableKey != "" {
+		return tblPath.tableName + tblPath.delimitor + tblPath.tableKey
+	}
+	return tblPath.tableName
+}
+
+// for subscribe request with granularity of table field, the value is read from
+// the shared source of the field upon its change, see field_source.go.
 // Upon value change, it will be put to queue for furhter notification
-func dbFieldMultiSubscribe(gnmiPath *gnmipb.Path, c *DbClient) {
+func dbFieldMultiSubscribe(gnmiPath *gnmipb.Path, tblPaths []tablePath, stop chan struct{}, c *DbClient) {
 	defer c.w.Done()
 
-	tblPaths := c.pathG2S[gnmiPath]
-
 	// Init the path to value map, it saves the previous value
 	path2ValueMap := make(map[tablePath]string)
-	for _, tblPath := range tblPaths {
+	notify := make(chan struct{}, 1)
+	sources := make([]*fieldSource, len(tblPaths))
+	for i, tblPath := range tblPaths {
 		path2ValueMap[tblPath] = ""
+		k := fieldKey{tblPath.dbNamespace, tblPath.dbName, fieldTableKey(&tblPath), tblPath.field}
+		sources[i] = subscribeField(k, notify)
+		defer sources[i].unsubscribe(notify)
 	}
 	synced := bool(false)
 
@@ -689,20 +1123,16 @@ func dbFieldMultiSubscribe(gnmiPath *gnmipb.Path, c *DbClient) {
 		case <-c.channel:
 			log.V(1).Infof("Stopping dbFieldMultiSubscribe routine for Client %s ", c)
 			return
-		default:
+		case <-stop:
+			log.V(1).Infof("Stopping dbFieldMultiSubscribe routine for %v ", gnmiPath)
+			return
+		case <-notify:
 			msi := make(map[string]interface{})
-			for _, tblPath := range tblPaths {
-				var key string
-				if tblPath.tableKey != "" {
-					key = tblPath.tableName + tblPath.delimitor + tblPath.tableKey
-				} else {
-					key = tblPath.tableName
-				}
-				// run redis get directly for field value
-				redisDb := Target2RedisDb[tblPath.dbName]
-				val, err := redisDb.HGet(key, tblPath.field).Result()
+			for i, tblPath := range tblPaths {
+				key := sources[i].key
+				val, err := sources[i].value()
 				if err == redis.Nil {
-					if tblPath.jsonField != "" {
+					if tblPath.jsonField != "" || tblPath.jsonNamespace != "" {
 						// ignore non-existing field which was derived from virtual path
 						continue
 					}
@@ -715,15 +1145,31 @@ func dbFieldMultiSubscribe(gnmiPath *gnmipb.Path, c *DbClient) {
 					enqueFatalMsg(c, fmt.Sprintf(" redis HGet error on %v with key %v", tblPath.field, key))
 					return
 				}
+				val = baselineValue(&tblPath, key, tblPath.field, val)
 				if val == path2ValueMap[tblPath] {
 					continue
 				}
 				path2ValueMap[tblPath] = val
-				fv := map[string]string{tblPath.jsonField: val}
-				msi[tblPath.jsonTableKey] = fv
+				if tblPath.jsonMemberKey != "" {
+					putMemberData(msi, &tblPath, map[string]string{tblPath.jsonField: val})
+				} else if tblPath.jsonField == "" {
+					// Field in table of one namespace
+					msi[tblPath.jsonNamespace] = val
+				} else {
+					fv := map[string]string{tblPath.jsonField: val}
+					nsMsi(msi, tblPath.jsonNamespace)[tblPath.jsonTableKey] = fv
+				}
 				log.V(6).Infof("new value %v for %v", val, tblPath)
 			}
 
+			// Unchanged members are needed for the sum of their object
+			aggregateMemberData(msi, tblPaths, func(tblPath *tablePath) error {
+				if val := path2ValueMap[*tblPath]; val != "" {
+					putMemberData(msi, tblPath, map[string]string{tblPath.jsonField: val})
+				}
+				return nil
+			})
+
 			if len(msi) != 0 {
 				val, err := msi2TypedValue(msi)
 				if err != nil {
@@ -748,28 +1194,21 @@ func dbFieldMultiSubscribe(gnmiPath *gnmipb.Path, c *DbClient) {
 					synced = true
 				}
 			}
-			// check again after 200 millisends, to use configured variable
-			time.Sleep(time.Millisecond * 200)
 		}
 	}
 }
 
-// for subscribe request with granularity of table field, the value is fetched periodically.
+// for subscribe request with granularity of table field, the value is read from
+// the shared source of the field upon its change, see field_source.go.
 // Upon value change, it will be put to queue for furhter notification
-func dbFieldSubscribe(gnmiPath *gnmipb.Path, c *DbClient) {
+func dbFieldSubscribe(gnmiPath *gnmipb.Path, tblPaths []tablePath, stop chan struct{}, c *DbClient) {
 	defer c.w.Done()
 
-	tblPaths := c.pathG2S[gnmiPath]
 	tblPath := tblPaths[0]
-	// run redis get directly for field value
-	redisDb := Target2RedisDb[tblPath.dbName]
-
-	var key string
-	if tblPath.tableKey != "" {
-		key = tblPath.tableName + tblPath.delimitor + tblPath.tableKey
-	} else {
-		key = tblPath.tableName
-	}
+	key := fieldTableKey(&tblPath)
+	notify := make(chan struct{}, 1)
+	source := subscribeField(fieldKey{tblPath.dbNamespace, tblPath.dbName, key, tblPath.field}, notify)
+	defer source.unsubscribe(notify)
 
 	var val string
 	for {
@@ -777,8 +1216,11 @@ func dbFieldSubscribe(gnmiPath *gnmipb.Path, c *DbClient) {
 		case <-c.channel:
 			log.V(1).Infof("Stopping dbFieldSubscribe routine for Client %s ", c)
 			return
-		default:
-			newVal, err := redisDb.HGet(key, tblPath.field).Result()
+		case <-stop:
+			log.V(1).Infof("Stopping dbFieldSubscribe routine for %v ", gnmiPath)
+			return
+		case <-notify:
+			newVal, err := source.value()
 			if err == redis.Nil {
 				log.V(2).Infof("%v doesn't exist with key %v in db", tblPath.field, key)
 				enqueFatalMsg(c, fmt.Sprintf("%v doesn't exist with key %v in db", tblPath.field, key))
@@ -789,6 +1231,7 @@ func dbFieldSubscribe(gnmiPath *gnmipb.Path, c *DbClient) {
 				enqueFatalMsg(c, fmt.Sprintf(" redis HGet error on %v with key %v", tblPath.field, key))
 				return
 			}
+			newVal = baselineValue(&tblPath, key, tblPath.field, newVal)
 			if newVal != val {
 				spbv := &spb.Value{
 					Prefix:    c.prefix,
@@ -811,8 +1254,6 @@ func dbFieldSubscribe(gnmiPath *gnmipb.Path, c *DbClient) {
 				}
 				val = newVal
 			}
-			// check again after 200 millisends, to use configured variable
-			time.Sleep(time.Millisecond * 200)
 		}
 	}
 }
@@ -821,6 +1262,7 @@ type redisSubData struct {
 	tblPath   tablePath
 	pubsub    *redis.PubSub
 	prefixLen int
+	stop      chan struct{}
 }
 
 // TODO: For delete operation, the exact content returned is to be clarified.
@@ -829,6 +1271,9 @@ func dbSingleTableKeySubscribe(rsd redisSubData, c *DbClient, msiOut *map[string
 	pubsub := rsd.pubsub
 	prefixLen := rsd.prefixLen
 	msi := make(map[string]interface{})
+	// Changes are merged into data of the namespace in msiOut
+	jsonNamespace := tblPath.jsonNamespace
+	tblPath.jsonNamespace = ""
 
 	for {
 		select {
@@ -892,29 +1337,33 @@ func dbSingleTableKeySubscribe(rsd redisSubData, c *DbClient, msiOut *map[string
 				continue
 			}
 			c.mu.Lock()
-			for k, v := range newMsi {
-				(*msiOut)[k] = v
-			}
+			mergeMsi(nsMsi(*msiOut, jsonNamespace), newMsi)
 			c.mu.Unlock()
 
 		case <-c.channel:
 			log.V(2).Infof("Stopping dbSingleTableKeySubscribe routine for %+v", tblPath)
 			return
+		case <-rsd.stop:
+			log.V(2).Infof("Stopping dbSingleTableKeySubscribe routine for %+v", tblPath)
+			return
 		}
 	}
 }
 
-func dbTableKeySubscribe(gnmiPath *gnmipb.Path, c *DbClient) {
+func dbTableKeySubscribe(gnmiPath *gnmipb.Path, tblPaths []tablePath, stop chan struct{}, c *DbClient) {
 	defer c.w.Done()
-
-	tblPaths := c.pathG2S[gnmiPath]
 	msi := make(map[string]interface{})
 
 	for _, tblPath := range tblPaths {
 		// Subscribe to keyspace notification
-		pattern := "__keyspace@" + strconv.Itoa(int(spb.Target_value[tblPath.dbName])) + "__:"
+		dbn, err := sdcfg.GetDbId(tblPath.dbName, tblPath.dbNamespace)
+		if err != nil {
+			enqueFatalMsg(c, err.Error())
+			return
+		}
+		pattern := "__keyspace@" + strconv.Itoa(dbn) + "__:"
 		pattern += tblPath.tableName
-		if tblPath.dbName == "COUNTERS_DB" && tblPath.tableName != "COUNTERS" {
+		if tblPath.dbName == "COUNTERS_DB" && tblPath.tableName != "COUNTERS" && tblPath.tableKey == "" {
 			// tables in COUNTERS_DB other than COUNTERS don't have keys, skip delimitor
 		} else {
 			pattern += tblPath.delimitor
@@ -928,7 +1377,7 @@ func dbTableKeySubscribe(gnmiPath *gnmipb.Path, c *DbClient) {
 			prefixLen = len(pattern)
 			pattern += "*"
 		}
-		redisDb := Target2RedisDb[tblPath.dbName]
+		redisDb := Target2RedisDb[tblPath.dbNamespace][tblPath.dbName]
 		pubsub := redisDb.PSubscribe(pattern)
 		defer pubsub.Close()
 
@@ -955,10 +1404,21 @@ func dbTableKeySubscribe(gnmiPath *gnmipb.Path, c *DbClient) {
 			tblPath:   tblPath,
 			pubsub:    pubsub,
 			prefixLen: prefixLen,
+			stop:      stop,
 		}
 		go dbSingleTableKeySubscribe(rsd, c, &msi)
 	}
 
+	fillMember := func(tblPath *tablePath) error {
+		return tableData2Msi(tblPath, false, nil, &msi)
+	}
+	c.mu.Lock()
+	err := aggregateMemberData(msi, tblPaths, fillMember)
+	c.mu.Unlock()
+	if err != nil {
+		enqueFatalMsg(c, err.Error())
+		return
+	}
 	val, err := msi2TypedValue(msi)
 	if err != nil {
 		enqueFatalMsg(c, err.Error())
@@ -987,7 +1447,10 @@ func dbTableKeySubscribe(gnmiPath *gnmipb.Path, c *DbClient) {
 			err = nil
 			c.mu.Lock()
 			if len(msi) > 0 {
-				val, err = msi2TypedValue(msi)
+				err = aggregateMemberData(msi, tblPaths, fillMember)
+				if err == nil {
+					val, err = msi2TypedValue(msi)
+				}
 				for k := range msi {
 					delete(msi, k)
 				}
@@ -1015,13 +1478,16 @@ func dbTableKeySubscribe(gnmiPath *gnmipb.Path, c *DbClient) {
 			// TODO: make all the instances of wait timer consistent
 			time.Sleep(time.Millisecond * 100)
 		case <-c.channel:
-			log.V(1).Infof("Stopping dbTableKeySubscribe routine for %v ", c.pathG2S)
+			log.V(1).Infof("Stopping dbTableKeySubscribe routine for %v ", gnmiPath)
+			return
+		case <-stop:
+			log.V(1).Infof("Stopping dbTableKeySubscribe routine for %v ", gnmiPath)
 			return
 		}
 	}
 }
 
-func  (c *DbClient) Set(path *gnmipb.Path, t *gnmipb.TypedValue, flagop int) error {
+func  (c *DbClient) Set(delete []*gnmipb.Path, replace []*gnmipb.Update, update []*gnmipb.Update) error {
 	return nil
 }
 func (c *DbClient) Capabilities() ([]gnmipb.ModelData) {
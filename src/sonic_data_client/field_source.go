@@ -0,0 +1,263 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+	log "github.com/golang/glog"
+	sdcfg "sonic_db_config"
+)
+
+// Field subscriptions of all clients share one source per redis field.
+// The source reads the field again upon keyspace notification of its key
+// if the DB has keyspace notifications of hash commands enabled, otherwise
+// it polls the field. Subscribers are signaled when the value changes and
+// read the latest value from the source.
+
+// Interval of polling fields of DBs without keyspace notifications
+const fieldPollInterval = 200 * time.Millisecond
+
+type fieldKey struct {
+	dbNamespace string
+	dbName      string
+	key         string
+	field       string
+}
+
+type fieldSource struct {
+	fieldKey
+	// Latest value of the field, err is redis.Nil if it doesn't exist
+	val  string
+	err  error
+	read bool
+	// Signal channels of subscribers with their number of subscriptions
+	subs map[chan struct{}]int
+	stop chan struct{}
+}
+
+// Watcher of keyspace notifications of keys in a DB, with one redis
+// connection for all its keys.
+type keyspaceWatcher struct {
+	dbNamespace   string
+	dbName        string
+	channelPrefix string
+	pubsub        *redis.PubSub
+	// Sources of fields per key
+	keys map[string]map[*fieldSource]bool
+	stop chan struct{}
+}
+
+var (
+	// Protects field sources and keyspace watchers
+	fieldMu      sync.Mutex
+	fieldSources = make(map[fieldKey]*fieldSource)
+	// Keyspace watchers per namespace then DB
+	keyspaceWatchers = make(map[string]map[string]*keyspaceWatcher)
+)
+
+// subscribeField subscribes to field k, notify is signaled when its value
+// changes. The value is read with the returned source.
+func subscribeField(k fieldKey, notify chan struct{}) *fieldSource {
+	fieldMu.Lock()
+	defer fieldMu.Unlock()
+	s, ok := fieldSources[k]
+	if !ok {
+		s = &fieldSource{
+			fieldKey: k,
+			subs:     make(map[chan struct{}]int),
+			stop:     make(chan struct{}),
+		}
+		fieldSources[k] = s
+		go s.run()
+	}
+	s.subs[notify]++
+	if s.read {
+		// Current value for the new subscriber
+		signal(notify)
+	}
+	return s
+}
+
+// unsubscribe removes a subscription of notify. The source stops with its
+// last subscription.
+func (s *fieldSource) unsubscribe(notify chan struct{}) {
+	fieldMu.Lock()
+	defer fieldMu.Unlock()
+	if s.subs[notify]--; s.subs[notify] > 0 {
+		return
+	}
+	delete(s.subs, notify)
+	if len(s.subs) == 0 {
+		delete(fieldSources, s.fieldKey)
+		close(s.stop)
+	}
+}
+
+// value returns the latest value of the field
+func (s *fieldSource) value() (string, error) {
+	fieldMu.Lock()
+	defer fieldMu.Unlock()
+	return s.val, s.err
+}
+
+// signal signals ch without blocking, pending signals being merged
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// update reads the field and signals subscribers if the value changed
+func (s *fieldSource) update() {
+	redisDb := Target2RedisDb[s.dbNamespace][s.dbName]
+	val, err := redisDb.HGet(s.key, s.field).Result()
+	fieldMu.Lock()
+	defer fieldMu.Unlock()
+	if s.read && val == s.val && err == s.err {
+		return
+	}
+	if err != nil && err != redis.Nil {
+		log.V(1).Infof("redis HGet error on %v with key %v: %v", s.field, s.key, err)
+	}
+	s.val, s.err, s.read = val, err, true
+	for ch := range s.subs {
+		signal(ch)
+	}
+}
+
+func (s *fieldSource) run() {
+	if w := watchKeyspace(s); w != nil {
+		// Read upon notifications until stopped
+		s.update()
+		<-s.stop
+		w.remove(s)
+		return
+	}
+
+	log.V(2).Infof("Polling %v of key %v in %v", s.field, s.key, s.dbName)
+	ticker := time.NewTicker(fieldPollInterval)
+	defer ticker.Stop()
+	for {
+		s.update()
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// keyspaceNotifyEnabled tells whether redisDb notifies changes of hash
+// fields in keyspace channels
+func keyspaceNotifyEnabled(redisDb *redis.Client) bool {
+	res, err := redisDb.ConfigGet("notify-keyspace-events").Result()
+	if err != nil || len(res) != 2 {
+		log.V(2).Infof("Failed to get notify-keyspace-events: %v %v", res, err)
+		return false
+	}
+	flags, _ := res[1].(string)
+	return strings.Contains(flags, "K") && strings.ContainsAny(flags, "Ah")
+}
+
+// watchKeyspace adds s to the keyspace watcher of its DB, nil is returned
+// if keyspace notifications are not enabled.
+func watchKeyspace(s *fieldSource) *keyspaceWatcher {
+	redisDb := Target2RedisDb[s.dbNamespace][s.dbName]
+	if !keyspaceNotifyEnabled(redisDb) {
+		return nil
+	}
+	dbn, err := sdcfg.GetDbId(s.dbName, s.dbNamespace)
+	if err != nil {
+		return nil
+	}
+
+	fieldMu.Lock()
+	defer fieldMu.Unlock()
+	if _, ok := keyspaceWatchers[s.dbNamespace]; !ok {
+		keyspaceWatchers[s.dbNamespace] = make(map[string]*keyspaceWatcher)
+	}
+	w, ok := keyspaceWatchers[s.dbNamespace][s.dbName]
+	if !ok {
+		w = &keyspaceWatcher{
+			dbNamespace:   s.dbNamespace,
+			dbName:        s.dbName,
+			channelPrefix: fmt.Sprintf("__keyspace@%d__:", dbn),
+			pubsub:        redisDb.Subscribe(),
+			keys:          make(map[string]map[*fieldSource]bool),
+			stop:          make(chan struct{}),
+		}
+		keyspaceWatchers[s.dbNamespace][s.dbName] = w
+		go w.run()
+	}
+	if _, ok := w.keys[s.key]; !ok {
+		w.keys[s.key] = make(map[*fieldSource]bool)
+		if err := w.pubsub.Subscribe(w.channelPrefix + s.key); err != nil {
+			log.V(1).Infof("subscribe to %s failed: %v", w.channelPrefix+s.key, err)
+		}
+	}
+	w.keys[s.key][s] = true
+	return w
+}
+
+// remove removes s from w. The watcher is closed with its last key.
+func (w *keyspaceWatcher) remove(s *fieldSource) {
+	fieldMu.Lock()
+	defer fieldMu.Unlock()
+	delete(w.keys[s.key], s)
+	if len(w.keys[s.key]) != 0 {
+		return
+	}
+	delete(w.keys, s.key)
+	if len(w.keys) != 0 {
+		w.pubsub.Unsubscribe(w.channelPrefix + s.key)
+		return
+	}
+	delete(keyspaceWatchers[w.dbNamespace], w.dbName)
+	close(w.stop)
+	w.pubsub.Close()
+}
+
+// notifyKey reads again fields of key upon its notification
+func (w *keyspaceWatcher) notifyKey(key string) {
+	var sources []*fieldSource
+	fieldMu.Lock()
+	for s := range w.keys[key] {
+		sources = append(sources, s)
+	}
+	fieldMu.Unlock()
+	for _, s := range sources {
+		s.update()
+	}
+}
+
+func (w *keyspaceWatcher) run() {
+	for {
+		select {
+		case <-w.stop:
+			log.V(2).Infof("Stopping keyspace watcher of %v in namespace %q", w.dbName, w.dbNamespace)
+			return
+		default:
+		}
+		msgi, err := w.pubsub.ReceiveTimeout(time.Millisecond * 500)
+		if err != nil {
+			if neterr, ok := err.(interface{ Timeout() bool }); !ok || !neterr.Timeout() {
+				log.V(2).Infof("pubsub.ReceiveTimeout err %v", err)
+				time.Sleep(fieldPollInterval)
+			}
+			continue
+		}
+		switch msg := msgi.(type) {
+		case *redis.Subscription:
+			// Changes before the subscription took effect may be missed
+			if msg.Kind == "subscribe" {
+				w.notifyKey(strings.TrimPrefix(msg.Channel, w.channelPrefix))
+			}
+		case *redis.Message:
+			w.notifyKey(strings.TrimPrefix(msg.Channel, w.channelPrefix))
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+// Prerequisite: redis-server should be running on localhost.
+
+const fieldSourceTestKey = "COUNTERS:oid:0xfield_source_test"
+
+// testCountersDb returns the client of COUNTERS_DB on the local redis
+// server, with notifications of keyspace events set to flags.
+func testCountersDb(t *testing.T, flags string) *redis.Client {
+	UseRedisLocalTcpPort = true
+	if err := LoadDbConfig("../testdata/database_config.json"); err != nil {
+		t.Fatal(err)
+	}
+	redisDb := Target2RedisDb[""]["COUNTERS_DB"]
+	if err := redisDb.Ping().Err(); err != nil {
+		t.Fatalf("failed to connect to redis server %v", err)
+	}
+	// Servers not supporting CONFIG have no keyspace notifications
+	redisDb.ConfigSet("notify-keyspace-events", flags)
+	redisDb.Del(fieldSourceTestKey)
+	return redisDb
+}
+
+// waitSignal waits for ch to be signaled
+func waitSignal(t *testing.T, ch chan struct{}, desc string) {
+	select {
+	case <-ch:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("%v not signaled", desc)
+	}
+}
+
+func TestFieldSourceShared(t *testing.T) {
+	redisDb := testCountersDb(t, "")
+	defer redisDb.Del(fieldSourceTestKey)
+	redisDb.HSet(fieldSourceTestKey, "SAI_PORT_STAT_IF_IN_OCTETS", "1")
+
+	k := fieldKey{dbName: "COUNTERS_DB", key: fieldSourceTestKey, field: "SAI_PORT_STAT_IF_IN_OCTETS"}
+	notify1 := make(chan struct{}, 1)
+	s1 := subscribeField(k, notify1)
+	defer s1.unsubscribe(notify1)
+	waitSignal(t, notify1, "first subscriber")
+
+	// The second subscriber is signaled of the value read already
+	notify2 := make(chan struct{}, 1)
+	s2 := subscribeField(k, notify2)
+	defer s2.unsubscribe(notify2)
+	if s1 != s2 {
+		t.Fatal("Subscribers of the same field have different sources")
+	}
+	waitSignal(t, notify2, "second subscriber")
+	if val, err := s2.value(); val != "1" || err != nil {
+		t.Fatalf("value() = %q, %v, want \"1\"", val, err)
+	}
+
+	redisDb.HSet(fieldSourceTestKey, "SAI_PORT_STAT_IF_IN_OCTETS", "2")
+	waitSignal(t, notify1, "first subscriber upon change")
+	waitSignal(t, notify2, "second subscriber upon change")
+	if val, err := s1.value(); val != "2" || err != nil {
+		t.Fatalf("value() = %q, %v, want \"2\"", val, err)
+	}
+}
+
+func TestFieldSourcePollFallback(t *testing.T) {
+	tests := []struct {
+		desc  string
+		flags string
+	}{
+		{"notifications disabled", ""},
+		{"no keyspace notifications", "Eh"},
+		{"no hash notifications", "Kg"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			redisDb := testCountersDb(t, tt.flags)
+			defer redisDb.Del(fieldSourceTestKey)
+
+			k := fieldKey{dbName: "COUNTERS_DB", key: fieldSourceTestKey, field: "SAI_PORT_STAT_IF_IN_OCTETS"}
+			notify := make(chan struct{}, 1)
+			s := subscribeField(k, notify)
+			defer s.unsubscribe(notify)
+			waitSignal(t, notify, "subscriber")
+			if _, err := s.value(); err != redis.Nil {
+				t.Fatalf("value() of missing field got error %v, want redis.Nil", err)
+			}
+
+			fieldMu.Lock()
+			w := keyspaceWatchers[""]["COUNTERS_DB"]
+			fieldMu.Unlock()
+			if w != nil {
+				t.Fatal("Keyspace watcher used without keyspace notifications of hash commands")
+			}
+			// Changed without notification
+			redisDb.HSet(fieldSourceTestKey, "SAI_PORT_STAT_IF_IN_OCTETS", "10")
+			waitSignal(t, notify, "subscriber upon change")
+			if val, err := s.value(); val != "10" || err != nil {
+				t.Fatalf("value() = %q, %v, want \"10\"", val, err)
+			}
+		})
+	}
+}
+
+func TestFieldSourceKeyspaceWatcher(t *testing.T) {
+	redisDb := testCountersDb(t, "KEA")
+	defer redisDb.Del(fieldSourceTestKey)
+	if !keyspaceNotifyEnabled(redisDb) {
+		t.Skip("Keyspace notifications not supported by redis server")
+	}
+
+	k := fieldKey{dbName: "COUNTERS_DB", key: fieldSourceTestKey, field: "SAI_PORT_STAT_IF_IN_OCTETS"}
+	notify := make(chan struct{}, 1)
+	s := subscribeField(k, notify)
+	waitSignal(t, notify, "subscriber")
+	fieldMu.Lock()
+	w := keyspaceWatchers[""]["COUNTERS_DB"]
+	fieldMu.Unlock()
+	if w == nil {
+		t.Fatal("Keyspace watcher not used with keyspace notifications enabled")
+	}
+
+	redisDb.HSet(fieldSourceTestKey, "SAI_PORT_STAT_IF_IN_OCTETS", "10")
+	waitSignal(t, notify, "subscriber upon change")
+	if val, err := s.value(); val != "10" || err != nil {
+		t.Fatalf("value() = %q, %v, want \"10\"", val, err)
+	}
+
+	// The watcher is closed with its last field
+	s.unsubscribe(notify)
+	select {
+	case <-w.stop:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Keyspace watcher not stopped after the last unsubscription")
+	}
+}
+
+func TestFieldSourceStop(t *testing.T) {
+	redisDb := testCountersDb(t, "")
+	defer redisDb.Del(fieldSourceTestKey)
+
+	k := fieldKey{dbName: "COUNTERS_DB", key: fieldSourceTestKey, field: "SAI_PORT_STAT_IF_IN_OCTETS"}
+	notify1 := make(chan struct{}, 1)
+	notify2 := make(chan struct{}, 1)
+	s := subscribeField(k, notify1)
+	subscribeField(k, notify1)
+	subscribeField(k, notify2)
+
+	stopped := func() bool {
+		select {
+		case <-s.stop:
+			return true
+		default:
+			return false
+		}
+	}
+	registered := func() bool {
+		fieldMu.Lock()
+		defer fieldMu.Unlock()
+		return fieldSources[k] == s
+	}
+
+	s.unsubscribe(notify2)
+	s.unsubscribe(notify1)
+	if stopped() || !registered() {
+		t.Fatal("Source stopped with a subscription left")
+	}
+	s.unsubscribe(notify1)
+	if !stopped() || registered() {
+		t.Fatal("Source not stopped after the last unsubscription")
+	}
+
+	// A new subscription starts a new source
+	s2 := subscribeField(k, notify1)
+	defer s2.unsubscribe(notify1)
+	if s2 == s {
+		t.Fatal("Stopped source reused")
+	}
+}
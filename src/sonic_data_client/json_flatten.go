@@ -0,0 +1,385 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+	spb "proto"
+)
+
+// JSON values of tables and containers may be flattened to one update per
+// leaf, ex. with option FlattenJSON of the server. The path of the leaf being the path of the value
+// followed by the names of JSON members down to the leaf, ex.
+// COUNTERS/Ethernet0 {"SAI_PORT_STAT_IF_IN_OCTETS": "123"} gives
+// COUNTERS/Ethernet0/SAI_PORT_STAT_IF_IN_OCTETS "123".
+//
+// A last path element with wildcard is replaced by the names of the top
+// level members, ex. COUNTERS/Ethernet* gives COUNTERS/Ethernet0/... A
+// top level member named as the last path element, as in JSON_IETF values
+// of translib, replaces it.
+//
+// JSON lists are keyed by their entries: by the scalar members of entries
+// having containers, as the key leaves of OpenConfig lists, otherwise by
+// member "name" if any. A list having entries without such keys is sent
+// as is, in one JSON value at the path of the list.
+
+// FlattenValue returns v with leaf updates of its JSON values. Other
+// values are kept as is.
+func FlattenValue(v *spb.Value) *spb.Value {
+	if v.GetSyncResponse() || v.GetFatal() != "" {
+		return v
+	}
+	updates := ValueUpdates(v)
+	flat := make([]*gnmipb.Update, 0, len(updates))
+	changed := false
+	for _, u := range updates {
+		leaves, ok := FlattenUpdate(u)
+		if !ok {
+			flat = append(flat, u)
+			continue
+		}
+		flat = append(flat, leaves...)
+		changed = true
+	}
+	if !changed {
+		return v
+	}
+	return &spb.Value{
+		Prefix:    v.GetPrefix(),
+		Timestamp: v.GetTimestamp(),
+		Updates:   flat,
+		Deletes:   v.GetDeletes(),
+	}
+}
+
+// FlattenUpdate returns leaf updates of the JSON value of u, false if u
+// doesn't have a JSON value.
+func FlattenUpdate(u *gnmipb.Update) ([]*gnmipb.Update, bool) {
+	f, ok := flattenJSON(u)
+	if !ok {
+		return nil, false
+	}
+	return f.updates, true
+}
+
+// flattenJSON walks the JSON value of u, false if u doesn't have a JSON
+// value.
+func flattenJSON(u *gnmipb.Update) (*flattener, bool) {
+	var data []byte
+	switch val := u.GetVal().GetValue().(type) {
+	case *gnmipb.TypedValue_JsonIetfVal:
+		data = val.JsonIetfVal
+	case *gnmipb.TypedValue_JsonVal:
+		data = val.JsonVal
+	default:
+		return nil, false
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var jv interface{}
+	if err := decoder.Decode(&jv); err != nil {
+		log.V(2).Infof("Not flattening invalid JSON of %v: %v", u.GetPath(), err)
+		return nil, false
+	}
+
+	_, ietf := u.GetVal().GetValue().(*gnmipb.TypedValue_JsonIetfVal)
+	f := &flattener{ietf: ietf}
+	path := u.GetPath()
+	elems := path.GetElem()
+	if len(elems) == 0 {
+		// Deprecated element names
+		for _, name := range path.GetElement() {
+			elems = append(elems, &gnmipb.PathElem{Name: name})
+		}
+	}
+	obj, ok := jv.(map[string]interface{})
+	if !ok {
+		f.walk(path, elems, jv)
+		return f, true
+	}
+	var last *gnmipb.PathElem
+	if len(elems) != 0 {
+		last = elems[len(elems)-1]
+	}
+	for _, name := range sortedKeys(obj) {
+		child := obj[name]
+		switch {
+		case last != nil && localName(name) == localName(last.GetName()):
+			// Member of the value itself
+			parent := elems[:len(elems)-1]
+			if entries, ok := child.([]interface{}); ok {
+				f.walkList(path, parent, last.GetName(), entries)
+			} else {
+				f.walk(path, appendElem(parent, last), child)
+			}
+		case last != nil && strings.Contains(last.GetName(), "*"):
+			f.walk(path, appendElem(elems[:len(elems)-1], &gnmipb.PathElem{Name: name}), child)
+		default:
+			f.walkMember(path, elems, name, child)
+		}
+	}
+	return f, true
+}
+
+type flattener struct {
+	// Whether the value flattened is JSON_IETF
+	ietf    bool
+	updates []*gnmipb.Update
+	// Paths of list entries
+	entries []*gnmipb.Path
+}
+
+// walkMember walks member name of value v in elems
+func (f *flattener) walkMember(path *gnmipb.Path, elems []*gnmipb.PathElem, name string, v interface{}) {
+	if entries, ok := v.([]interface{}); ok {
+		f.walkList(path, elems, name, entries)
+		return
+	}
+	f.walk(path, appendElem(elems, &gnmipb.PathElem{Name: name}), v)
+}
+
+// walk adds leaf updates of v at elems
+func (f *flattener) walk(path *gnmipb.Path, elems []*gnmipb.PathElem, v interface{}) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for _, name := range sortedKeys(val) {
+			f.walkMember(path, elems, name, val[name])
+		}
+	case []interface{}:
+		// Leaf-list
+		arr := &gnmipb.ScalarArray{}
+		for _, e := range val {
+			if tv := scalarTypedValue(e); tv != nil {
+				arr.Element = append(arr.Element, tv)
+			}
+		}
+		f.add(path, elems, &gnmipb.TypedValue{Value: &gnmipb.TypedValue_LeaflistVal{LeaflistVal: arr}})
+	default:
+		if tv := scalarTypedValue(val); tv != nil {
+			f.add(path, elems, tv)
+		}
+	}
+}
+
+// walkList adds leaf updates of list entries named name at elems
+func (f *flattener) walkList(path *gnmipb.Path, elems []*gnmipb.PathElem, name string, entries []interface{}) {
+	keys := make([]map[string]string, len(entries))
+	for i, e := range entries {
+		entry, ok := e.(map[string]interface{})
+		if !ok {
+			// Leaf-list
+			f.walk(path, appendElem(elems, &gnmipb.PathElem{Name: name}), entries)
+			return
+		}
+		if keys[i], ok = listEntryKeys(entry); !ok {
+			// Entries can't be told apart by path
+			f.addJSON(path, appendElem(elems, &gnmipb.PathElem{Name: name}), entries)
+			return
+		}
+	}
+	for i, entry := range entries {
+		entryElems := appendElem(elems, &gnmipb.PathElem{Name: name, Key: keys[i]})
+		f.entries = append(f.entries, f.path(path, entryElems))
+		f.walk(path, entryElems, entry)
+	}
+}
+
+func (f *flattener) add(path *gnmipb.Path, elems []*gnmipb.PathElem, val *gnmipb.TypedValue) {
+	f.updates = append(f.updates, &gnmipb.Update{
+		Path: f.path(path, elems),
+		Val:  val,
+	})
+}
+
+// addJSON adds an update of v at elems, encoded as the value flattened
+func (f *flattener) addJSON(path *gnmipb.Path, elems []*gnmipb.PathElem, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		log.V(2).Infof("Failed to encode JSON of %v: %v", elems, err)
+		return
+	}
+	val := &gnmipb.TypedValue{Value: &gnmipb.TypedValue_JsonVal{JsonVal: data}}
+	if f.ietf {
+		val = &gnmipb.TypedValue{Value: &gnmipb.TypedValue_JsonIetfVal{JsonIetfVal: data}}
+	}
+	f.add(path, elems, val)
+}
+
+// path returns the path of elems under the origin and target of path
+func (f *flattener) path(path *gnmipb.Path, elems []*gnmipb.PathElem) *gnmipb.Path {
+	return &gnmipb.Path{
+		Origin: path.GetOrigin(),
+		Target: path.GetTarget(),
+		Elem:   elems,
+	}
+}
+
+// listEntryKeys returns keys of list entry, false if it has none
+func listEntryKeys(entry map[string]interface{}) (map[string]string, bool) {
+	keys := make(map[string]string)
+	hasContainer := false
+	for name, v := range entry {
+		switch v.(type) {
+		case map[string]interface{}, []interface{}:
+			hasContainer = true
+		default:
+			keys[localName(name)] = scalarString(v)
+		}
+	}
+	if hasContainer && len(keys) != 0 {
+		return keys, true
+	}
+	if name, ok := entry["name"]; ok {
+		return map[string]string{"name": scalarString(name)}, true
+	}
+	return nil, false
+}
+
+// scalarTypedValue returns the typed value of JSON scalar v, nil for null
+func scalarTypedValue(v interface{}) *gnmipb.TypedValue {
+	switch val := v.(type) {
+	case string:
+		return &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{StringVal: val}}
+	case bool:
+		return &gnmipb.TypedValue{Value: &gnmipb.TypedValue_BoolVal{BoolVal: val}}
+	case json.Number:
+		if n, err := val.Int64(); err == nil {
+			return &gnmipb.TypedValue{Value: &gnmipb.TypedValue_IntVal{IntVal: n}}
+		}
+		if n, err := strconv.ParseUint(val.String(), 10, 64); err == nil {
+			return &gnmipb.TypedValue{Value: &gnmipb.TypedValue_UintVal{UintVal: n}}
+		}
+		if n, err := val.Float64(); err == nil {
+			return &gnmipb.TypedValue{Value: &gnmipb.TypedValue_FloatVal{FloatVal: float32(n)}}
+		}
+		return &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{StringVal: val.String()}}
+	}
+	return nil
+}
+
+func scalarString(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case json.Number:
+		return val.String()
+	case bool:
+		return strconv.FormatBool(val)
+	}
+	return ""
+}
+
+// localName returns name without module prefix
+func localName(name string) string {
+	if i := strings.Index(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// appendElem returns a new slice of elems followed by elem
+func appendElem(elems []*gnmipb.PathElem, elem *gnmipb.PathElem) []*gnmipb.PathElem {
+	path := make([]*gnmipb.PathElem, len(elems), len(elems)+1)
+	copy(path, elems)
+	return append(path, elem)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Leaves and list entries of a JSON value
+type jsonLeaves struct {
+	updates []*gnmipb.Update
+	// Leaves and list entries per path
+	leaves  map[string]*gnmipb.Update
+	entries map[string]*gnmipb.Path
+}
+
+// newJSONLeaves returns leaves of JSON value val of path, nil if val is
+// not JSON.
+func newJSONLeaves(path *gnmipb.Path, val *gnmipb.TypedValue) *jsonLeaves {
+	f, ok := flattenJSON(&gnmipb.Update{Path: path, Val: val})
+	if !ok {
+		return nil
+	}
+	l := &jsonLeaves{
+		updates: f.updates,
+		leaves:  make(map[string]*gnmipb.Update),
+		entries: make(map[string]*gnmipb.Path),
+	}
+	for _, u := range f.updates {
+		l.leaves[proto.CompactTextString(u.GetPath())] = u
+	}
+	for _, p := range f.entries {
+		l.entries[proto.CompactTextString(p)] = p
+	}
+	return l
+}
+
+// diff returns updates of leaves new or changed in cur since l, and
+// deletes of list entries of l gone in cur, then of other leaves gone.
+func (l *jsonLeaves) diff(cur *jsonLeaves) ([]*gnmipb.Update, []*gnmipb.Path) {
+	var updates []*gnmipb.Update
+	for _, u := range cur.updates {
+		old, ok := l.leaves[proto.CompactTextString(u.GetPath())]
+		if !ok || !proto.Equal(old.GetVal(), u.GetVal()) {
+			updates = append(updates, u)
+		}
+	}
+
+	var deletes []*gnmipb.Path
+	for key, p := range l.entries {
+		if _, ok := cur.entries[key]; !ok {
+			deletes = append(deletes, p)
+		}
+	}
+	entryDeletes := len(deletes)
+	for _, u := range l.updates {
+		if _, ok := cur.leaves[proto.CompactTextString(u.GetPath())]; ok {
+			continue
+		}
+		deleted := false
+		for _, d := range deletes[:entryDeletes] {
+			if PathHasPrefix(u.GetPath(), d) {
+				deleted = true
+				break
+			}
+		}
+		if !deleted {
+			deletes = append(deletes, u.GetPath())
+		}
+	}
+	return updates, deletes
+}
+
+// PathHasPrefix tells whether path is prefix or under it
+func PathHasPrefix(path *gnmipb.Path, prefix *gnmipb.Path) bool {
+	elems, pelems := path.GetElem(), prefix.GetElem()
+	if len(elems) < len(pelems) {
+		return false
+	}
+	for i, pe := range pelems {
+		e := elems[i]
+		if e.GetName() != pe.GetName() || len(e.GetKey()) != len(pe.GetKey()) {
+			return false
+		}
+		for k, v := range pe.GetKey() {
+			if e.GetKey()[k] != v {
+				return false
+			}
+		}
+	}
+	return true
+}
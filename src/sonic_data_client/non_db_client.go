@@ -63,6 +63,10 @@ var (
 			path:    []string{"OTHERS", "proc", "stat"},
 			getFunc: dataGetFunc(getProcStat),
 		},
+		{ // Get statistics of samples of translib paths
+			path:    []string{"OTHERS", "telemetry", "sample_stats"},
+			getFunc: dataGetFunc(getSampleStats),
+		},
 	}
 )
 
@@ -348,22 +352,25 @@ func (c *NonDbClient) PollRun(q *queue.PriorityQueue, poll chan struct{}, w *syn
 			return
 		}
 		t1 := time.Now()
+		// All paths are sent in one value of the poll time
+		spbv := &spb.Value{
+			Prefix:    c.prefix,
+			Timestamp: t1.UnixNano(),
+		}
 		for gnmiPath, getter := range c.path2Getter {
 			v, err := getter()
 			if err != nil {
 				log.V(3).Infof("PollRun getter error %v for %v", err, v)
 			}
-			spbv := &spb.Value{
-				Prefix:       c.prefix,
-				Path:         gnmiPath,
-				Timestamp:    time.Now().UnixNano(),
-				SyncResponse: false,
+			spbv.Updates = append(spbv.Updates, &gnmipb.Update{
+				Path: gnmiPath,
 				Val: &gnmipb.TypedValue{
 					Value: &gnmipb.TypedValue_JsonIetfVal{
 						JsonIetfVal: v,
 					}},
-			}
-
+			})
+		}
+		if len(spbv.Updates) != 0 {
 			c.q.Put(Value{spbv})
 			log.V(6).Infof("Added spbv #%v", spbv)
 		}
@@ -411,7 +418,7 @@ func (c *NonDbClient) Close() error {
 	return nil
 }
 
-func  (c *NonDbClient) Set(path *gnmipb.Path, t *gnmipb.TypedValue, flagop int) error {
+func  (c *NonDbClient) Set(delete []*gnmipb.Path, replace []*gnmipb.Update, update []*gnmipb.Update) error {
 	return nil
 }
 func (c *NonDbClient) Capabilities() ([]gnmipb.ModelData) {
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/go-redis/redis"
+	"github.com/golang/protobuf/proto"
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	transutil "transl_utils"
+)
+
+// Paths of a Get are read concurrently, by up to getWorkers at once. As
+// the gNMI spec requires, the Get fails if any path fails, with the code
+// of the first path failing and a ResourceInfo detail per path failing.
+
+// Paths read at once by a Get
+const getWorkers = 8
+
+// An error with the gRPC code to report it with
+type codeError struct {
+	code codes.Code
+	msg  string
+}
+
+func (e *codeError) Error() string {
+	return e.msg
+}
+
+// errorf returns an error of code
+func errorf(code codes.Code, format string, a ...interface{}) error {
+	return &codeError{code: code, msg: fmt.Sprintf(format, a...)}
+}
+
+// PathError is the error reading a path
+type PathError struct {
+	Path *gnmipb.Path
+	Err  error
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("%v: %v", pathString(e.Path), status.Convert(e.Err).Message())
+}
+
+// GetError is the errors of the paths failing a Get, in request order
+type GetError []*PathError
+
+func (e GetError) Error() string {
+	var msgs []string
+	for _, pe := range e {
+		msgs = append(msgs, pe.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// pathString returns path as an URI
+func pathString(path *gnmipb.Path) string {
+	var uri string
+	transutil.ConvertToURI(nil, path, &uri)
+	return uri
+}
+
+// ErrorCode returns the gRPC code to report err reading data with.
+// Errors not known otherwise are taken as data not found.
+func ErrorCode(err error) codes.Code {
+	switch err {
+	case context.Canceled:
+		return codes.Canceled
+	case context.DeadlineExceeded:
+		return codes.DeadlineExceeded
+	case redis.Nil:
+		return codes.NotFound
+	case io.EOF:
+		return codes.Unavailable
+	}
+	switch e := err.(type) {
+	case *codeError:
+		return e.code
+	case GetError:
+		return ErrorCode(e[0].Err)
+	case *PathError:
+		return ErrorCode(e.Err)
+	case net.Error:
+		return codes.Unavailable
+	}
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+	return codes.NotFound
+}
+
+// ErrorStatus returns the status error of err reading data. For a
+// GetError, details are the paths failing followed by the details of
+// their errors.
+func ErrorStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	st := status.New(ErrorCode(err), err.Error())
+	ge, ok := err.(GetError)
+	if !ok {
+		return st.Err()
+	}
+	var details []proto.Message
+	for _, pe := range ge {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: "gnmi.Path",
+			ResourceName: pathString(pe.Path),
+			Description:  fmt.Sprintf("%v: %v", ErrorCode(pe.Err), status.Convert(pe.Err).Message()),
+		})
+	}
+	for _, pe := range ge {
+		for _, d := range status.Convert(pe.Err).Details() {
+			if m, ok := d.(proto.Message); ok {
+				details = append(details, m)
+			}
+		}
+	}
+	if dst, err := st.WithDetails(details...); err == nil {
+		st = dst
+	}
+	return st.Err()
+}
+
+// getPaths reads values of paths by get, by up to getWorkers at once. The
+// error is a GetError of the paths failing, if any.
+func getPaths(paths []*gnmipb.Path, get func(*gnmipb.Path) (*gnmipb.TypedValue, error)) ([]*gnmipb.TypedValue, error) {
+	vals := make([]*gnmipb.TypedValue, len(paths))
+	errs := make([]error, len(paths))
+	workers := make(chan struct{}, getWorkers)
+	var wg sync.WaitGroup
+	for i, path := range paths {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(i int, path *gnmipb.Path) {
+			defer wg.Done()
+			vals[i], errs[i] = get(path)
+			<-workers
+		}(i, path)
+	}
+	wg.Wait()
+
+	var ge GetError
+	for i, err := range errs {
+		if err != nil {
+			ge = append(ge, &PathError{Path: paths[i], Err: err})
+		}
+	}
+	if ge != nil {
+		return nil, ge
+	}
+	return vals, nil
+}
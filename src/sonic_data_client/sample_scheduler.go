@@ -0,0 +1,256 @@
+package client
+
+import (
+	"container/heap"
+	"encoding/json"
+	"sync"
+	"time"
+
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// Samples of SAMPLE subscriptions and heartbeats of translib paths are run
+// by a scheduler per client. Samples of the same interval are staggered
+// over it, and run by a bounded pool of workers so a slow path delays
+// neither the other paths nor the schedule. A sample due while the
+// previous one of its path still runs is skipped, as are samples missed
+// while the scheduler was late. Statistics of samples of all clients are
+// available at OTHERS/telemetry/sample_stats.
+
+// Workers running samples of a client at once
+const sampleWorkers = 4
+
+// A path sampled, by its sample and heartbeat jobs
+type sampledPath struct {
+	sub *gnmipb.Subscription
+	uri string
+	// Set while a sample of the path runs
+	running bool
+	// Leaves last sent, with suppress_redundant
+	leaves *jsonLeaves
+}
+
+type sampleJob struct {
+	path      *sampledPath
+	interval  time.Duration
+	heartbeat bool
+	due       time.Time
+}
+
+// Jobs ordered by due time
+type sampleHeap []*sampleJob
+
+func (h sampleHeap) Len() int            { return len(h) }
+func (h sampleHeap) Less(i, j int) bool  { return h[i].due.Before(h[j].due) }
+func (h sampleHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *sampleHeap) Push(x interface{}) { *h = append(*h, x.(*sampleJob)) }
+func (h *sampleHeap) Pop() interface{} {
+	old := *h
+	job := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return job
+}
+
+type sampleScheduler struct {
+	mu      sync.Mutex
+	jobs    sampleHeap
+	workers chan struct{}
+	wg      sync.WaitGroup
+	// Runs a sample of job
+	sample func(job *sampleJob)
+}
+
+func newSampleScheduler(sample func(job *sampleJob)) *sampleScheduler {
+	return &sampleScheduler{
+		workers: make(chan struct{}, sampleWorkers),
+		sample:  sample,
+	}
+}
+
+// add schedules a sample of path every interval
+func (s *sampleScheduler) add(path *sampledPath, interval time.Duration, heartbeat bool) {
+	s.jobs = append(s.jobs, &sampleJob{path: path, interval: interval, heartbeat: heartbeat})
+	sampleStats.addPath(path.uri)
+}
+
+// close removes the jobs of s, once it is not running
+func (s *sampleScheduler) close() {
+	for _, job := range s.jobs {
+		sampleStats.removePath(job.path.uri)
+	}
+	s.jobs = nil
+}
+
+// stagger sets the first due time of jobs, spreading the ones of the same
+// interval evenly over the interval from now
+func (s *sampleScheduler) stagger(now time.Time) {
+	count := make(map[time.Duration]int)
+	for _, job := range s.jobs {
+		count[job.interval]++
+	}
+	index := make(map[time.Duration]int)
+	for _, job := range s.jobs {
+		offset := job.interval * time.Duration(index[job.interval]) / time.Duration(count[job.interval])
+		job.due = now.Add(job.interval + offset)
+		index[job.interval]++
+	}
+	heap.Init(&s.jobs)
+}
+
+// run runs samples until stop is closed, then waits for the samples
+// running.
+func (s *sampleScheduler) run(stop chan struct{}) {
+	defer s.wg.Wait()
+	if len(s.jobs) == 0 {
+		<-stop
+		return
+	}
+	s.stagger(time.Now())
+	timer := time.NewTimer(time.Until(s.jobs[0].due))
+	defer timer.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-timer.C:
+		}
+		now := time.Now()
+		for !s.jobs[0].due.After(now) {
+			job := s.jobs[0]
+			due := job.due
+			job.due = due.Add(job.interval)
+			if !job.due.After(now) {
+				missed := now.Sub(due) / job.interval
+				job.due = due.Add((missed + 1) * job.interval)
+				sampleStats.skip(uint64(missed))
+			}
+			heap.Fix(&s.jobs, 0)
+			s.dispatch(job, due, stop)
+		}
+		timer.Reset(time.Until(s.jobs[0].due))
+	}
+}
+
+// dispatch runs a sample of job due at due once a worker is free, unless
+// a sample of its path is running already
+func (s *sampleScheduler) dispatch(job *sampleJob, due time.Time, stop chan struct{}) {
+	s.mu.Lock()
+	if job.path.running {
+		s.mu.Unlock()
+		sampleStats.skip(1)
+		return
+	}
+	job.path.running = true
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer func() {
+			s.mu.Lock()
+			job.path.running = false
+			s.mu.Unlock()
+		}()
+		select {
+		case s.workers <- struct{}{}:
+		case <-stop:
+			return
+		}
+		defer func() { <-s.workers }()
+		sampleStats.start(time.Since(due))
+		s.sample(job)
+	}()
+}
+
+// Errors of samples of a path
+type samplePathErrors struct {
+	Count     uint64 `json:"count"`
+	LastError string `json:"last_error"`
+	LastTime  int64  `json:"last_time"`
+}
+
+// Statistics of samples of all clients
+type sampleStatistics struct {
+	mu sync.Mutex
+	// Samples started, and skipped
+	started uint64
+	skipped uint64
+	// Lateness of samples started, from their due time
+	lateness    time.Duration
+	maxLateness time.Duration
+	errors      map[string]*samplePathErrors
+	// Jobs scheduled per path, errors of a path being removed with its
+	// last job
+	paths map[string]int
+}
+
+var sampleStats = sampleStatistics{
+	errors: make(map[string]*samplePathErrors),
+	paths:  make(map[string]int),
+}
+
+func (st *sampleStatistics) addPath(uri string) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.paths[uri]++
+}
+
+func (st *sampleStatistics) removePath(uri string) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.paths[uri]--; st.paths[uri] <= 0 {
+		delete(st.paths, uri)
+		delete(st.errors, uri)
+	}
+}
+
+func (st *sampleStatistics) start(lateness time.Duration) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.started++
+	st.lateness += lateness
+	if lateness > st.maxLateness {
+		st.maxLateness = lateness
+	}
+}
+
+func (st *sampleStatistics) skip(n uint64) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.skipped += n
+}
+
+// pathError records err of a sample of uri
+func (st *sampleStatistics) pathError(uri string, err error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	pe, ok := st.errors[uri]
+	if !ok {
+		pe = &samplePathErrors{}
+		st.errors[uri] = pe
+	}
+	pe.Count++
+	pe.LastError = err.Error()
+	pe.LastTime = time.Now().UnixNano()
+}
+
+func getSampleStats() ([]byte, error) {
+	sampleStats.mu.Lock()
+	defer sampleStats.mu.Unlock()
+	stats := struct {
+		Started       uint64                       `json:"started"`
+		Skipped       uint64                       `json:"skipped"`
+		AvgLatenessMs float64                      `json:"avg_lateness_ms"`
+		MaxLatenessMs float64                      `json:"max_lateness_ms"`
+		Errors        map[string]*samplePathErrors `json:"errors"`
+	}{
+		Started:       sampleStats.started,
+		Skipped:       sampleStats.skipped,
+		MaxLatenessMs: sampleStats.maxLateness.Seconds() * 1000,
+		Errors:        sampleStats.errors,
+	}
+	if sampleStats.started > 0 {
+		stats.AvgLatenessMs = sampleStats.lateness.Seconds() * 1000 / float64(sampleStats.started)
+	}
+	return json.Marshal(stats)
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSampleStagger(t *testing.T) {
+	s := newSampleScheduler(nil)
+	defer s.close()
+	for _, uri := range []string{"/a", "/b", "/c", "/d"} {
+		s.add(&sampledPath{uri: uri}, 4*time.Second, false)
+	}
+	s.add(&sampledPath{uri: "/e"}, time.Second, true)
+
+	now := time.Now()
+	s.stagger(now)
+	want := map[string]time.Duration{
+		"/a": 4 * time.Second,
+		"/b": 5 * time.Second,
+		"/c": 6 * time.Second,
+		"/d": 7 * time.Second,
+		"/e": time.Second,
+	}
+	for _, job := range s.jobs {
+		if got := job.due.Sub(now); got != want[job.path.uri] {
+			t.Errorf("%v due in %v, want %v", job.path.uri, got, want[job.path.uri])
+		}
+	}
+	if s.jobs[0].path.uri != "/e" {
+		t.Errorf("first job %v, want /e", s.jobs[0].path.uri)
+	}
+}
+
+// skipped returns the number of samples skipped so far
+func skipped() uint64 {
+	sampleStats.mu.Lock()
+	defer sampleStats.mu.Unlock()
+	return sampleStats.skipped
+}
+
+func TestSampleSkipRunning(t *testing.T) {
+	var mu sync.Mutex
+	samples := 0
+	release := make(chan struct{})
+	s := newSampleScheduler(func(job *sampleJob) {
+		mu.Lock()
+		samples++
+		mu.Unlock()
+		<-release
+	})
+	s.add(&sampledPath{uri: "/slow"}, 10*time.Millisecond, false)
+	defer s.close()
+
+	before := skipped()
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		s.run(stop)
+		close(done)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	mu.Lock()
+	n := samples
+	mu.Unlock()
+	close(stop)
+	close(release)
+	<-done
+
+	if n != 1 {
+		t.Errorf("%v samples run, want 1 while the first one is running", n)
+	}
+	if skipped() == before {
+		t.Error("No sample skipped while the path is being sampled")
+	}
+}
+
+func TestSampleWorkers(t *testing.T) {
+	var mu sync.Mutex
+	running, maxRunning, samples := 0, 0, 0
+	s := newSampleScheduler(func(job *sampleJob) {
+		mu.Lock()
+		running++
+		samples++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		time.Sleep(30 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+	})
+	for _, uri := range []string{"/1", "/2", "/3", "/4", "/5", "/6", "/7", "/8"} {
+		s.add(&sampledPath{uri: uri}, 20*time.Millisecond, false)
+	}
+	defer s.close()
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		s.run(stop)
+		close(done)
+	}()
+	time.Sleep(200 * time.Millisecond)
+	close(stop)
+	<-done
+
+	if maxRunning != sampleWorkers {
+		t.Errorf("%v samples run at once, want %v", maxRunning, sampleWorkers)
+	}
+	if samples < len(s.jobs) {
+		t.Errorf("%v samples run, want every path sampled", samples)
+	}
+}
+
+func TestSampleErrorsRemoved(t *testing.T) {
+	s1 := newSampleScheduler(nil)
+	s1.add(&sampledPath{uri: "/errors"}, time.Second, false)
+	s2 := newSampleScheduler(nil)
+	s2.add(&sampledPath{uri: "/errors"}, time.Second, false)
+	sampleStats.pathError("/errors", errors.New("failed"))
+
+	hasErrors := func() bool {
+		sampleStats.mu.Lock()
+		defer sampleStats.mu.Unlock()
+		_, ok := sampleStats.errors["/errors"]
+		return ok
+	}
+	s1.close()
+	if !hasErrors() {
+		t.Fatal("Errors removed while the path is sampled by another client")
+	}
+	s2.close()
+	if hasErrors() {
+		t.Fatal("Errors kept after the last job of the path is removed")
+	}
+}
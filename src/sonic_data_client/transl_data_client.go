@@ -1,30 +1,25 @@
-//  Package client provides a generic access layer for data available in system
+// Package client provides a generic access layer for data available in system
 package client
 
 import (
-	spb "proto"
-	transutil "transl_utils"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Workiva/go-datastructures/queue"
 	log "github.com/golang/glog"
 	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
-	"github.com/Workiva/go-datastructures/queue"
+	spb "proto"
 	"sync"
 	"time"
-	"fmt"
-	"reflect"
+	transutil "transl_utils"
 	"translib"
-	"bytes"
-	"encoding/json"
-	"context"
-)
-
-const (
-	DELETE  int = 0
-	REPLACE int = 1
-	UPDATE  int = 2
 )
 
 type TranslClient struct {
 	prefix *gnmipb.Path
+	/* GNMI Paths of Get in request order */
+	paths []*gnmipb.Path
 	/* GNMI Path to REST URL Mapping */
 	path2URI map[*gnmipb.Path]string
 	channel  chan struct{}
@@ -33,7 +28,7 @@ type TranslClient struct {
 	synced sync.WaitGroup  // Control when to send gNMI sync_response
 	w      *sync.WaitGroup // wait for all sub go routines to finish
 	mu     sync.RWMutex    // Mutex for data protection among routines for transl_client
-	ctx context.Context //Contains Auth info and request info
+	ctx    context.Context //Contains Auth info and request info
 
 }
 
@@ -42,6 +37,7 @@ func NewTranslClient(prefix *gnmipb.Path, getpaths []*gnmipb.Path, ctx context.C
 	var err error
 	client.ctx = ctx
 	client.prefix = prefix
+	client.paths = getpaths
 	if getpaths != nil {
 		client.path2URI = make(map[*gnmipb.Path]string)
 		/* Populate GNMI path to REST URL map. */
@@ -59,21 +55,21 @@ func (c *TranslClient) Get(w *sync.WaitGroup) ([]*spb.Value, error) {
 	var values []*spb.Value
 	ts := time.Now()
 
-	/* Iterate through all GNMI paths. */
-	for gnmiPath, URIPath := range c.path2URI {
-		/* Fill values for each GNMI path. */
-		val, err := transutil.TranslProcessGet(URIPath, nil, c.ctx)
-
-		if err != nil {
-			return nil, err
-		}
+	/* Fill values for each GNMI path, concurrently. */
+	vals, err := getPaths(c.paths, func(gnmiPath *gnmipb.Path) (*gnmipb.TypedValue, error) {
+		return transutil.TranslProcessGet(c.path2URI[gnmiPath], nil, c.ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
 
+	for i, gnmiPath := range c.paths {
 		/* Value of each path is added to spb value structure. */
 		values = append(values, &spb.Value{
 			Prefix:    c.prefix,
 			Path:      gnmiPath,
 			Timestamp: ts.UnixNano(),
-			Val:       val,
+			Val:       vals[i],
 		})
 	}
 
@@ -86,22 +82,9 @@ func (c *TranslClient) Get(w *sync.WaitGroup) ([]*spb.Value, error) {
 	return values, nil
 }
 
-func (c *TranslClient) Set(path *gnmipb.Path, val *gnmipb.TypedValue, flagop int) error {
-	var uri string
-	var err error
-
-	/* Convert the GNMI Path to URI. */
-	transutil.ConvertToURI(c.prefix, path, &uri)
-
-	if flagop == DELETE {
-		err = transutil.TranslProcessDelete(uri, c.ctx)
-	} else if flagop == REPLACE {
-		err = transutil.TranslProcessReplace(uri, val, c.ctx)
-	} else if flagop == UPDATE {
-		err = transutil.TranslProcessUpdate(uri, val, c.ctx)
-	}
-
-	return err
+func (c *TranslClient) Set(delete []*gnmipb.Path, replace []*gnmipb.Update, update []*gnmipb.Update) error {
+	/* All operations are sent to translib at once, in one transaction. */
+	return transutil.TranslProcessBulk(delete, replace, update, c.prefix, c.ctx)
 }
 func enqueFatalMsgTranslib(c *TranslClient, msg string) {
 	c.q.Put(Value{
@@ -111,11 +94,6 @@ func enqueFatalMsgTranslib(c *TranslClient, msg string) {
 		},
 	})
 }
-type ticker_info struct{
-	t              *time.Ticker
-	sub            *gnmipb.Subscription
-	heartbeat      bool
-}
 
 func (c *TranslClient) StreamRun(q *queue.PriorityQueue, stop chan struct{}, w *sync.WaitGroup, subscribe *gnmipb.SubscriptionList) {
 	c.w = w
@@ -123,24 +101,20 @@ func (c *TranslClient) StreamRun(q *queue.PriorityQueue, stop chan struct{}, w *
 	c.q = q
 	c.channel = stop
 
-
-	ticker_map := make(map[int][]*ticker_info)
-	var cases []reflect.SelectCase
-	cases_map := make(map[int]int)
+	sched := newSampleScheduler(c.sample)
+	defer sched.close()
 	var subscribe_mode gnmipb.SubscriptionMode
 	stringPaths := make([]string, len(subscribe.Subscription))
-	for i,sub := range subscribe.Subscription {
+	for i, sub := range subscribe.Subscription {
 		stringPaths[i] = c.path2URI[sub.Path]
 	}
-	req := translib.IsSubscribeRequest{Paths:stringPaths}
-	subSupport,_ := translib.IsSubscribeSupported(req)
+	req := translib.IsSubscribeRequest{Paths: stringPaths}
+	subSupport, _ := translib.IsSubscribeSupported(req)
 	var onChangeSubsString []string
 	var onChangeSubsgNMI []*gnmipb.Path
 	onChangeMap := make(map[string]*gnmipb.Path)
-	valueCache := make(map[string]string)
 
-	for i,sub := range subscribe.Subscription {
-		fmt.Println(sub.Mode, sub.SampleInterval)
+	for i, sub := range subscribe.Subscription {
 		switch sub.Mode {
 
 		case gnmipb.SubscriptionMode_TARGET_DEFINED:
@@ -157,9 +131,9 @@ func (c *TranslClient) StreamRun(q *queue.PriorityQueue, stop chan struct{}, w *
 
 		case gnmipb.SubscriptionMode_ON_CHANGE:
 			if subSupport[i].Err == nil && subSupport[i].IsOnChangeSupported {
-				if (subSupport[i].MinInterval > 0) {
+				if subSupport[i].MinInterval > 0 {
 					subscribe_mode = gnmipb.SubscriptionMode_ON_CHANGE
-				}else{
+				} else {
 					enqueFatalMsgTranslib(c, fmt.Sprintf("Invalid subscribe path %v", stringPaths[i]))
 					return
 				}
@@ -168,9 +142,9 @@ func (c *TranslClient) StreamRun(q *queue.PriorityQueue, stop chan struct{}, w *
 				return
 			}
 		case gnmipb.SubscriptionMode_SAMPLE:
-			if (subSupport[i].MinInterval > 0) {
+			if subSupport[i].MinInterval > 0 {
 				subscribe_mode = gnmipb.SubscriptionMode_SAMPLE
-			}else{
+			} else {
 				enqueFatalMsgTranslib(c, fmt.Sprintf("Invalid subscribe path %v", stringPaths[i]))
 				return
 			}
@@ -179,56 +153,46 @@ func (c *TranslClient) StreamRun(q *queue.PriorityQueue, stop chan struct{}, w *
 			enqueFatalMsgTranslib(c, fmt.Sprintf("Invalid Subscription Mode %d", sub.Mode))
 			return
 		}
-		fmt.Println("subscribe_mode:", subscribe_mode)
+		log.V(2).Infof("Subscribe mode of %v: %v", stringPaths[i], subscribe_mode)
 		if subscribe_mode == gnmipb.SubscriptionMode_SAMPLE {
 			interval := int(sub.SampleInterval)
 			if interval == 0 {
 				interval = subSupport[i].MinInterval * int(time.Second)
 			} else {
-				if interval < (subSupport[i].MinInterval*int(time.Second)) {
+				if interval < (subSupport[i].MinInterval * int(time.Second)) {
 					enqueFatalMsgTranslib(c, fmt.Sprintf("Invalid Sample Interval %ds, minimum interval is %ds", interval/int(time.Second), subSupport[i].MinInterval))
 					return
 				}
 			}
-			
+
+			path := &sampledPath{sub: sub, uri: c.path2URI[sub.Path]}
 			if !subscribe.UpdatesOnly {
 				//Send initial data now so we can send sync response, unless updates_only is set.
-				val, err := transutil.TranslProcessGet(c.path2URI[sub.Path], nil, c.ctx)
-				if err != nil {
-					return
-				}
-				spbv := &spb.Value{
-					Prefix:       c.prefix,
-					Path:         sub.Path,
-					Timestamp:    time.Now().UnixNano(),
-					SyncResponse: false,
-					Val:          val,
-				}
-				c.q.Put(Value{spbv})
-				valueCache[c.path2URI[sub.Path]] = string(val.GetJsonIetfVal())
+				//A path failing is sampled again on schedule.
+				c.sample(&sampleJob{path: path})
 			}
-			
-			addTimer(c, ticker_map, &cases, cases_map, interval, sub, false)
+
+			sched.add(path, time.Duration(interval), false)
 			//Heartbeat intervals are valid for SAMPLE in the case suppress_redundant is specified
 			if sub.SuppressRedundant && sub.HeartbeatInterval > 0 {
-				if int(sub.HeartbeatInterval) < subSupport[i].MinInterval * int(time.Second) {
+				if int(sub.HeartbeatInterval) < subSupport[i].MinInterval*int(time.Second) {
 					enqueFatalMsgTranslib(c, fmt.Sprintf("Invalid Heartbeat Interval %ds, minimum interval is %ds", int(sub.HeartbeatInterval)/int(time.Second), subSupport[i].MinInterval))
 					return
 				}
-				addTimer(c, ticker_map, &cases, cases_map, int(sub.HeartbeatInterval), sub, true)
+				sched.add(path, time.Duration(sub.HeartbeatInterval), true)
 			}
 		} else if subscribe_mode == gnmipb.SubscriptionMode_ON_CHANGE {
 			onChangeSubsString = append(onChangeSubsString, c.path2URI[sub.Path])
 			onChangeSubsgNMI = append(onChangeSubsgNMI, sub.Path)
 			onChangeMap[c.path2URI[sub.Path]] = sub.Path
 			if sub.HeartbeatInterval > 0 {
-				if int(sub.HeartbeatInterval) < subSupport[i].MinInterval * int(time.Second) {
+				if int(sub.HeartbeatInterval) < subSupport[i].MinInterval*int(time.Second) {
 					enqueFatalMsgTranslib(c, fmt.Sprintf("Invalid Heartbeat Interval %ds, minimum interval is %ds", int(sub.HeartbeatInterval)/int(time.Second), subSupport[i].MinInterval))
 					return
 				}
-				addTimer(c, ticker_map, &cases, cases_map, int(sub.HeartbeatInterval), sub, true)
+				sched.add(&sampledPath{sub: sub, uri: c.path2URI[sub.Path]}, time.Duration(sub.HeartbeatInterval), true)
 			}
-			
+
 		}
 	}
 	if len(onChangeSubsString) > 0 {
@@ -245,71 +209,61 @@ func (c *TranslClient) StreamRun(q *queue.PriorityQueue, stop chan struct{}, w *
 		SyncResponse: true,
 	}
 	c.q.Put(Value{spbs})
-	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(c.channel)})
-
-	for {
-		chosen, _, ok := reflect.Select(cases)
-
+	sched.run(c.channel)
+}
 
-		if !ok {
-			return
-		}
+// sample sends the value of the path of job, or with suppress_redundant
+// only the leaves changed since the last value sent and deletes of the
+// ones removed, unless for a heartbeat or a value not JSON.
+func (c *TranslClient) sample(job *sampleJob) {
+	p := job.path
+	val, err := transutil.TranslProcessGet(p.uri, nil, c.ctx)
+	if err != nil {
+		log.V(2).Infof("Sample of %v failed: %v", p.uri, err)
+		sampleStats.pathError(p.uri, err)
+		return
+	}
+	spbv := &spb.Value{
+		Prefix:       c.prefix,
+		Path:         p.sub.Path,
+		Timestamp:    time.Now().UnixNano(),
+		SyncResponse: false,
+		Val:          val,
+	}
 
-		for _,tick := range ticker_map[cases_map[chosen]] {
-			fmt.Printf("tick, heartbeat: %t, path: %s", tick.heartbeat, c.path2URI[tick.sub.Path])
-			val, err := transutil.TranslProcessGet(c.path2URI[tick.sub.Path], nil, c.ctx)
-			if err != nil {
+	if p.sub.SuppressRedundant {
+		cur := newJSONLeaves(p.sub.Path, val)
+		prev := p.leaves
+		p.leaves = cur
+		if !job.heartbeat && prev != nil && cur != nil {
+			updates, deletes := prev.diff(cur)
+			if len(updates) == 0 && len(deletes) == 0 {
+				log.V(6).Infof("Redundant Message Suppressed #%v", string(val.GetJsonIetfVal()))
 				return
 			}
-			spbv := &spb.Value{
-				Prefix:       c.prefix,
-				Path:         tick.sub.Path,
-				Timestamp:    time.Now().UnixNano(),
-				SyncResponse: false,
-				Val:          val,
-			}
-			
-
-			if (tick.sub.SuppressRedundant) && (!tick.heartbeat) && (string(val.GetJsonIetfVal()) == valueCache[c.path2URI[tick.sub.Path]]) {
-				log.V(6).Infof("Redundant Message Suppressed #%v", string(val.GetJsonIetfVal()))
-			} else {
-				c.q.Put(Value{spbv})
-				valueCache[c.path2URI[tick.sub.Path]] = string(val.GetJsonIetfVal())
-				log.V(6).Infof("Added spbv #%v", spbv)
+			spbv = &spb.Value{
+				Prefix:    c.prefix,
+				Timestamp: spbv.Timestamp,
+				Updates:   updates,
+				Deletes:   deletes,
 			}
-			
-			
 		}
 	}
-}
-
-func addTimer(c *TranslClient, ticker_map map[int][]*ticker_info, cases *[]reflect.SelectCase, cases_map map[int]int, interval int, sub *gnmipb.Subscription, heartbeat bool) {
-	//Reuse ticker for same sample intervals, otherwise create a new one.
-	if ticker_map[interval] == nil {
-		ticker_map[interval] = make([]*ticker_info, 1, 1)
-		ticker_map[interval][0] = &ticker_info {
-			t: time.NewTicker(time.Duration(interval) * time.Nanosecond),
-			sub: sub,
-			heartbeat: heartbeat,
-		}
-		cases_map[len(*cases)] = interval
-		*cases = append(*cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ticker_map[interval][0].t.C)})
-	}else {
-		ticker_map[interval] = append(ticker_map[interval], &ticker_info {
-			t: ticker_map[interval][0].t,
-			sub: sub,
-			heartbeat: heartbeat,
-		})
-	}
-	
-
+	c.q.Put(Value{spbv})
+	log.V(6).Infof("Added spbv #%v", spbv)
 }
 
 func TranslSubscribe(gnmiPaths []*gnmipb.Path, stringPaths []string, pathMap map[string]*gnmipb.Path, c *TranslClient, updates_only bool) {
 	defer c.w.Done()
 	q := queue.NewPriorityQueue(1, false)
 	var sync_done bool
-	req := translib.SubscribeRequest{Paths:stringPaths, Q:q, Stop:c.channel}
+	defer func() {
+		// StreamRun is not to wait for the sync forever upon error
+		if !sync_done {
+			c.synced.Done()
+		}
+	}()
+	req := translib.SubscribeRequest{Paths: stringPaths, Q: q, Stop: c.channel}
 	translib.Subscribe(req)
 	for {
 		items, err := q.Get(1)
@@ -321,9 +275,9 @@ func TranslSubscribe(gnmiPaths []*gnmipb.Path, stringPaths []string, pathMap map
 		case *translib.SubscribeResponse:
 
 			if v.IsTerminated {
-				//DB Connection or other backend error
+				//DB Connection or other backend error. The owner of
+				//c.channel closes it upon the fatal message.
 				enqueFatalMsgTranslib(c, "DB Connection Error")
-				close(c.channel)
 				return
 			}
 
@@ -335,7 +289,7 @@ func TranslSubscribe(gnmiPaths []*gnmipb.Path, stringPaths []string, pathMap map
 			/* Fill the values into GNMI data structures . */
 			val := &gnmipb.TypedValue{
 				Value: &gnmipb.TypedValue_JsonIetfVal{
-				JsonIetfVal: jv,
+					JsonIetfVal: jv,
 				}}
 
 			spbv := &spb.Value{
@@ -354,9 +308,8 @@ func TranslSubscribe(gnmiPaths []*gnmipb.Path, stringPaths []string, pathMap map
 			}
 
 			log.V(6).Infof("Added spbv #%v", spbv)
-			
+
 			if v.SyncComplete && !sync_done {
-				fmt.Println("SENDING SYNC")
 				c.synced.Done()
 				sync_done = true
 			}
@@ -366,8 +319,6 @@ func TranslSubscribe(gnmiPaths []*gnmipb.Path, stringPaths []string, pathMap map
 	}
 }
 
-
-
 func (c *TranslClient) PollRun(q *queue.PriorityQueue, poll chan struct{}, w *sync.WaitGroup, subscribe *gnmipb.SubscriptionList) {
 	c.w = w
 	defer c.w.Done()
@@ -381,6 +332,11 @@ func (c *TranslClient) PollRun(q *queue.PriorityQueue, poll chan struct{}, w *sy
 			return
 		}
 		t1 := time.Now()
+		// All paths are sent in one value of the poll time
+		spbv := &spb.Value{
+			Prefix:    c.prefix,
+			Timestamp: t1.UnixNano(),
+		}
 		for gnmiPath, URIPath := range c.path2URI {
 			if synced || !subscribe.UpdatesOnly {
 
@@ -389,18 +345,16 @@ func (c *TranslClient) PollRun(q *queue.PriorityQueue, poll chan struct{}, w *sy
 					return
 				}
 
-				spbv := &spb.Value{
-					Prefix:       c.prefix,
-					Path:         gnmiPath,
-					Timestamp:    time.Now().UnixNano(),
-					SyncResponse: false,
-					Val:          val,
-				}
-
-				c.q.Put(Value{spbv})
-				log.V(6).Infof("Added spbv #%v", spbv)
+				spbv.Updates = append(spbv.Updates, &gnmipb.Update{
+					Path: gnmiPath,
+					Val:  val,
+				})
 			}
 		}
+		if len(spbv.Updates) != 0 {
+			c.q.Put(Value{spbv})
+			log.V(6).Infof("Added spbv #%v", spbv)
+		}
 
 		c.q.Put(Value{
 			&spb.Value{
@@ -418,7 +372,6 @@ func (c *TranslClient) OnceRun(q *queue.PriorityQueue, once chan struct{}, w *sy
 	c.q = q
 	c.channel = once
 
-	
 	_, more := <-c.channel
 	if !more {
 		log.V(1).Infof("%v once channel closed, exiting onceDb routine", c)
@@ -426,7 +379,7 @@ func (c *TranslClient) OnceRun(q *queue.PriorityQueue, once chan struct{}, w *sy
 	}
 	t1 := time.Now()
 	for gnmiPath, URIPath := range c.path2URI {
-		
+
 		val, err := transutil.TranslProcessGet(URIPath, nil, c.ctx)
 		if err != nil {
 			return
@@ -452,7 +405,7 @@ func (c *TranslClient) OnceRun(q *queue.PriorityQueue, once chan struct{}, w *sy
 		},
 	})
 	log.V(4).Infof("Sync done, once time taken: %v ms", int64(time.Since(t1)/time.Millisecond))
-	
+
 }
 
 func (c *TranslClient) Capabilities() []gnmipb.ModelData {
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"sort"
 	"strings"
 )
 
@@ -130,27 +131,18 @@ func (n Node) Val() string {
 	return n.val
 }
 
+// Finds the node of the key matching keys, trying the child of the
+// same name first, then the wildcard children by longest prefix.
 func findNode(node *Node, keys []string) *Node {
 	if node == nil {
 		return nil
 	}
 
 	if len(keys) == 0 {
-		return node
-	}
-
-	n, ok := node.Children()[keys[0]]
-	if !ok {
-		var val string
-		for val, n = range node.wildcards {
-			if strings.HasPrefix(keys[0], val[:len(val)-1]) {
-				ok = true
-				break
-			}
-		}
-		if !ok {
-			return nil
+		if n, ok := node.Children()[""]; ok && n.term {
+			return node
 		}
+		return nil
 	}
 
 	var nkeys []string
@@ -158,5 +150,67 @@ func findNode(node *Node, keys []string) *Node {
 		nkeys = keys[1:]
 	}
 
-	return findNode(n, nkeys)
+	if n, ok := node.Children()[keys[0]]; ok {
+		if found := findNode(n, nkeys); found != nil {
+			return found
+		}
+	}
+	for _, val := range node.sortedWildcards() {
+		if val == keys[0] || !strings.HasPrefix(keys[0], val[:len(val)-1]) {
+			continue
+		}
+		if found := findNode(node.wildcards[val], nkeys); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// Returns wildcard children of the node, longest prefix first
+func (n *Node) sortedWildcards() []string {
+	vals := make([]string, 0, len(n.wildcards))
+	for val := range n.wildcards {
+		vals = append(vals, val)
+	}
+	sort.Slice(vals, func(i, j int) bool {
+		if len(vals[i]) != len(vals[j]) {
+			return len(vals[i]) > len(vals[j])
+		}
+		return vals[i] < vals[j]
+	})
+	return vals
+}
+
+// Tells whether any key in the Trie may match the same path as keys,
+// where elements of both may have a wildcard suffix "*".
+func (t *Trie) Overlaps(keys []string) bool {
+	return overlapNode(t.Root(), keys)
+}
+
+func overlapNode(node *Node, keys []string) bool {
+	if len(keys) == 0 {
+		n, ok := node.Children()[""]
+		return ok && n.term
+	}
+	for val, n := range node.Children() {
+		if val != "" && overlapKey(val, keys[0]) && overlapNode(n, keys[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Tells whether path elements a and b may match the same name
+func overlapKey(a, b string) bool {
+	wa, wb := strings.HasSuffix(a, "*"), strings.HasSuffix(b, "*")
+	switch {
+	case wa && wb:
+		pa, pb := a[:len(a)-1], b[:len(b)-1]
+		return strings.HasPrefix(pa, pb) || strings.HasPrefix(pb, pa)
+	case wa:
+		return strings.HasPrefix(b, a[:len(a)-1])
+	case wb:
+		return strings.HasPrefix(a, b[:len(b)-1])
+	}
+	return a == b
 }
@@ -3,7 +3,13 @@ package client
 import (
 	"fmt"
 	log "github.com/golang/glog"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
+
+	sdcfg "sonic_db_config"
 )
 
 // virtual db is to Handle
@@ -18,7 +24,12 @@ const (
 	FieldIdx             // Field name is the first element (no. 3) in path slice.
 )
 
-type v2rTranslate func([]string) ([]tablePath, error)
+// Translate virtual path to real data paths in namespace
+type v2rTranslate func([]string, string) ([]tablePath, error)
+
+// Delay to reload name maps after the first change notification,
+// so that a burst of changes like port breakout leads to one reload
+const nameMapRefreshDelay = time.Second
 
 type pathTransFunc struct {
 	path      []string
@@ -28,19 +39,48 @@ type pathTransFunc struct {
 var (
 	v2rTrie *Trie
 
+	// Protects the name maps below, which may be refreshed upon
+	// change notifications while being used for path translation.
+	nameMapMu sync.RWMutex
+	// Incremented on each refresh of the name maps
+	nameMapGen uint64
+	// Namespaces whose name maps are being watched for change
+	nameMapWatched = make(map[string]bool)
+
+	// All maps below are per namespace, keyed by namespace name first.
+
 	// Port name to oid map in COUNTERS table of COUNTERS_DB
-	countersPortNameMap = make(map[string]string)
+	countersPortNameMap = make(map[string]map[string]string)
 
 	// Queue name to oid map in COUNTERS table of COUNTERS_DB
-	countersQueueNameMap = make(map[string]string)
+	countersQueueNameMap = make(map[string]map[string]string)
 
 	// Alias translation: from vendor port name to sonic interface name
-	alias2nameMap = make(map[string]string)
+	alias2nameMap = make(map[string]map[string]string)
 	// Alias translation: from sonic interface name to vendor port name
-	name2aliasMap = make(map[string]string)
+	name2aliasMap = make(map[string]map[string]string)
 
 	// SONiC interface name to their PFC-WD enabled queues, then to oid map
-	countersPfcwdNameMap = make(map[string]map[string]string)
+	countersPfcwdNameMap = make(map[string]map[string]map[string]string)
+
+	// PortChannel name to its member SONiC interface names
+	lagMemberMap = make(map[string]map[string][]string)
+
+	// Priority group name to oid map in COUNTERS table of COUNTERS_DB
+	countersPgNameMap = make(map[string]map[string]string)
+
+	// Buffer pool name to oid map in COUNTERS_DB
+	countersBufferPoolNameMap = make(map[string]map[string]string)
+
+	// Router interface name to oid map in COUNTERS table of COUNTERS_DB
+	countersRifNameMap = make(map[string]map[string]string)
+
+	// ACL table name to its rule names, sorted
+	aclRuleMap = make(map[string]map[string][]string)
+
+	// Tables in COUNTERS_DB holding watermarks of queues, priority groups
+	// and buffer pools, keyed by their oids
+	watermarkTables = []string{"USER_WATERMARKS", "PERSISTENT_WATERMARKS", "PERIODIC_WATERMARKS"}
 
 	// path2TFuncTbl is used to populate trie tree which is reponsible
 	// for virtual path to real data path translation
@@ -54,9 +94,42 @@ var (
 		}, { // Queue stats for one or all Ethernet ports
 			path:      []string{"COUNTERS_DB", "COUNTERS", "Ethernet*", "Queues"},
 			transFunc: v2rTranslate(v2rEthPortQueStats),
+		}, { // Rates of counters for one or all Ethernet ports
+			path:      []string{"COUNTERS_DB", "COUNTERS", "Ethernet*", "Rates"},
+			transFunc: v2rTranslate(v2rEthPortRates),
 		}, { // PFC WD stats for one or all Ethernet ports
 			path:      []string{"COUNTERS_DB", "COUNTERS", "Ethernet*", "Pfcwd"},
 			transFunc: v2rTranslate(v2rEthPortPfcwdStats),
+		}, { // stats for one or all PortChannels, summed from member ports
+			path:      []string{"COUNTERS_DB", "COUNTERS", "PortChannel*"},
+			transFunc: v2rTranslate(v2rLagStats),
+		}, { // specific field stats for one or all PortChannels
+			path:      []string{"COUNTERS_DB", "COUNTERS", "PortChannel*", "*"},
+			transFunc: v2rTranslate(v2rLagFieldStats),
+		}, { // Priority group stats for one or all Ethernet ports
+			path:      []string{"COUNTERS_DB", "COUNTERS", "Ethernet*", "PriorityGroups"},
+			transFunc: v2rTranslate(v2rEthPortPgStats),
+		}, { // Watermarks of priority groups and queues for one or all Ethernet ports
+			path:      []string{"COUNTERS_DB", "COUNTERS", "Ethernet*", "Watermarks"},
+			transFunc: v2rTranslate(v2rEthPortWatermarks),
+		}, { // Watermarks of all buffer pools
+			path:      []string{"COUNTERS_DB", "COUNTERS", "BufferPools"},
+			transFunc: v2rTranslate(v2rBufferPoolWatermarks),
+		}, { // Watermarks of one buffer pool
+			path:      []string{"COUNTERS_DB", "COUNTERS", "BufferPools", "*"},
+			transFunc: v2rTranslate(v2rBufferPoolWatermarks),
+		}, { // stats for all router interfaces
+			path:      []string{"COUNTERS_DB", "COUNTERS", "RIF"},
+			transFunc: v2rTranslate(v2rRifStats),
+		}, { // stats for one or all router interfaces
+			path:      []string{"COUNTERS_DB", "COUNTERS", "RIF", "*"},
+			transFunc: v2rTranslate(v2rRifStats),
+		}, { // counters of all rules in one or all ACL tables
+			path:      []string{"COUNTERS_DB", "ACL", "*"},
+			transFunc: v2rTranslate(v2rAclRuleStats),
+		}, { // counters of one or all rules in one or all ACL tables
+			path:      []string{"COUNTERS_DB", "ACL", "*", "*"},
+			transFunc: v2rTranslate(v2rAclRuleStats),
 		},
 	}
 )
@@ -73,57 +146,344 @@ func (t *Trie) v2rTriePopulate() {
 	}
 }
 
-func initCountersQueueNameMap() error {
-	var err error
-	if len(countersQueueNameMap) == 0 {
-		countersQueueNameMap, err = getCountersMap("COUNTERS_QUEUE_NAME_MAP")
+func initCountersQueueNameMap(ns string) error {
+	if len(countersQueueNameMap[ns]) == 0 {
+		m, err := getCountersMap("COUNTERS_QUEUE_NAME_MAP", ns)
+		if err != nil {
+			return err
+		}
+		countersQueueNameMap[ns] = m
+	}
+	return nil
+}
+
+func initCountersPortNameMap(ns string) error {
+	if len(countersPortNameMap[ns]) == 0 {
+		m, err := getCountersMap("COUNTERS_PORT_NAME_MAP", ns)
+		if err != nil {
+			return err
+		}
+		countersPortNameMap[ns] = m
+	}
+	return nil
+}
+
+func initAliasMap(ns string) error {
+	if len(alias2nameMap[ns]) == 0 {
+		a2n, n2a, err := getAliasMap(ns)
+		if err != nil {
+			return err
+		}
+		alias2nameMap[ns], name2aliasMap[ns] = a2n, n2a
+	}
+	return nil
+}
+
+func initCountersPfcwdNameMap(ns string) error {
+	if len(countersPfcwdNameMap[ns]) == 0 {
+		m, err := getPfcwdMap(ns, countersQueueNameMap[ns])
+		if err != nil {
+			return err
+		}
+		countersPfcwdNameMap[ns] = m
+	}
+	return nil
+}
+
+func initCountersPgNameMap(ns string) error {
+	if len(countersPgNameMap[ns]) == 0 {
+		m, err := getCountersMap("COUNTERS_PG_NAME_MAP", ns)
+		if err != nil {
+			return err
+		}
+		countersPgNameMap[ns] = m
+	}
+	return nil
+}
+
+func initCountersBufferPoolNameMap(ns string) error {
+	if len(countersBufferPoolNameMap[ns]) == 0 {
+		m, err := getCountersMap("COUNTERS_BUFFER_POOL_NAME_MAP", ns)
+		if err != nil {
+			return err
+		}
+		countersBufferPoolNameMap[ns] = m
+	}
+	return nil
+}
+
+func initLagMemberMap(ns string) error {
+	if len(lagMemberMap[ns]) == 0 {
+		m, err := getLagMemberMap(ns)
 		if err != nil {
 			return err
 		}
+		lagMemberMap[ns] = m
 	}
 	return nil
 }
 
-func initCountersPortNameMap() error {
-	var err error
-	if len(countersPortNameMap) == 0 {
-		countersPortNameMap, err = getCountersMap("COUNTERS_PORT_NAME_MAP")
+func initCountersRifNameMap(ns string) error {
+	if len(countersRifNameMap[ns]) == 0 {
+		m, err := getCountersMap("COUNTERS_RIF_NAME_MAP", ns)
 		if err != nil {
 			return err
 		}
+		countersRifNameMap[ns] = m
 	}
 	return nil
 }
 
-func initAliasMap() error {
-	var err error
-	if len(alias2nameMap) == 0 {
-		alias2nameMap, name2aliasMap, err = getAliasMap()
+func initAclRuleMap(ns string) error {
+	if len(aclRuleMap[ns]) == 0 {
+		m, err := getAclRuleMap(ns)
 		if err != nil {
 			return err
 		}
+		aclRuleMap[ns] = m
+	}
+	return nil
+}
+
+// Prepare the name maps of namespace ns for virtual path translation,
+// and keep them updated afterwards.
+func initCountersNameMap(ns string) error {
+	nameMapMu.Lock()
+	defer nameMapMu.Unlock()
+
+	if !nameMapWatched[ns] {
+		if err := watchCountersNameMap(ns); err != nil {
+			// Name maps are still loaded below, but not refreshed
+			log.V(1).Infof("Failed to watch name maps in namespace %q: %v", ns, err)
+		} else {
+			nameMapWatched[ns] = true
+		}
+	}
+
+	err := initCountersPortNameMap(ns)
+	if err != nil {
+		return err
+	}
+	err = initCountersQueueNameMap(ns)
+	if err != nil {
+		return err
+	}
+	err = initCountersPgNameMap(ns)
+	if err != nil {
+		return err
+	}
+	err = initCountersBufferPoolNameMap(ns)
+	if err != nil {
+		return err
+	}
+	err = initCountersRifNameMap(ns)
+	if err != nil {
+		return err
+	}
+	err = initAliasMap(ns)
+	if err != nil {
+		return err
+	}
+	err = initLagMemberMap(ns)
+	if err != nil {
+		return err
+	}
+	err = initAclRuleMap(ns)
+	if err != nil {
+		return err
+	}
+	err = initDefNameMaps(ns)
+	if err != nil {
+		return err
+	}
+	return initCountersPfcwdNameMap(ns)
+}
+
+// getNameMapGen returns the current generation of name maps. Table paths
+// translated from virtual path are stale once it changes.
+func getNameMapGen() uint64 {
+	nameMapMu.RLock()
+	defer nameMapMu.RUnlock()
+	return nameMapGen
+}
+
+// refreshCountersNameMap reloads all name maps of namespace ns, and
+// replaces the ones in use at once. Maps which failed to reload keep
+// their old content, and an error is returned for the refresh to be
+// retried.
+func refreshCountersNameMap(ns string) error {
+	var failed []string
+	check := func(name string, err error) bool {
+		if err != nil {
+			log.V(1).Infof("Failed to refresh %v in namespace %q: %v", name, ns, err)
+			failed = append(failed, name)
+			return false
+		}
+		return true
 	}
+
+	portMap, err := getCountersMap("COUNTERS_PORT_NAME_MAP", ns)
+	portOk := check("port name map", err)
+	queueMap, err := getCountersMap("COUNTERS_QUEUE_NAME_MAP", ns)
+	queueOk := check("queue name map", err)
+	pgMap, err := getCountersMap("COUNTERS_PG_NAME_MAP", ns)
+	pgOk := check("priority group name map", err)
+	bufferPoolMap, err := getCountersMap("COUNTERS_BUFFER_POOL_NAME_MAP", ns)
+	bufferPoolOk := check("buffer pool name map", err)
+	rifMap, err := getCountersMap("COUNTERS_RIF_NAME_MAP", ns)
+	rifOk := check("router interface name map", err)
+	a2n, n2a, err := getAliasMap(ns)
+	aliasOk := check("alias map", err)
+	lagMap, err := getLagMemberMap(ns)
+	lagOk := check("PortChannel member map", err)
+	aclMap, err := getAclRuleMap(ns)
+	aclOk := check("ACL rule map", err)
+	nameMapMu.RLock()
+	// PFC WD map is derived from the queue name map, the old one if
+	// it failed to reload
+	pfcwdQueueMap := queueMap
+	if !queueOk {
+		pfcwdQueueMap = countersQueueNameMap[ns]
+	}
+	defMaps, err := getDefNameMaps(ns)
+	defOk := check("name maps of defined virtual paths", err)
+	nameMapMu.RUnlock()
+	pfcwdMap, err := getPfcwdMap(ns, pfcwdQueueMap)
+	pfcwdOk := check("PFC WD map", err)
+
+	nameMapMu.Lock()
+	if portOk {
+		countersPortNameMap[ns] = portMap
+	}
+	if queueOk {
+		countersQueueNameMap[ns] = queueMap
+	}
+	if pgOk {
+		countersPgNameMap[ns] = pgMap
+	}
+	if bufferPoolOk {
+		countersBufferPoolNameMap[ns] = bufferPoolMap
+	}
+	if rifOk {
+		countersRifNameMap[ns] = rifMap
+	}
+	if aliasOk {
+		alias2nameMap[ns], name2aliasMap[ns] = a2n, n2a
+	}
+	if pfcwdOk {
+		countersPfcwdNameMap[ns] = pfcwdMap
+	}
+	if lagOk {
+		lagMemberMap[ns] = lagMap
+	}
+	if aclOk {
+		aclRuleMap[ns] = aclMap
+	}
+	if defOk {
+		defNameMaps[ns] = defMaps
+	}
+	nameMapGen++
+	nameMapMu.Unlock()
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to refresh %v in namespace %q", strings.Join(failed, ", "), ns)
+	}
+	log.V(2).Infof("Name maps refreshed in namespace %q, %v ports %v queues", ns, len(portMap), len(queueMap))
 	return nil
 }
 
-func initCountersPfcwdNameMap() error {
-	var err error
-	if len(countersPfcwdNameMap) == 0 {
-		countersPfcwdNameMap, err = getPfcwdMap()
+// keyspacePattern returns the keyspace notification pattern of key
+// pattern in dbName of namespace ns
+func keyspacePattern(dbName, ns, key string) (string, error) {
+	dbn, err := sdcfg.GetDbId(dbName, ns)
+	if err != nil {
+		return "", err
+	}
+	return "__keyspace@" + strconv.Itoa(dbn) + "__:" + key, nil
+}
+
+// watchCountersNameMap subscribes to changes of the tables which name maps
+// of namespace ns are derived from, and refreshes the maps upon change.
+func watchCountersNameMap(ns string) error {
+	countersDb, ok := Target2RedisDb[ns]["COUNTERS_DB"]
+	if !ok {
+		return fmt.Errorf("COUNTERS_DB not found in namespace %q", ns)
+	}
+	configDb, ok := Target2RedisDb[ns]["CONFIG_DB"]
+	if !ok {
+		return fmt.Errorf("CONFIG_DB not found in namespace %q", ns)
+	}
+	separator, err := GetTableKeySeparator("CONFIG_DB", ns)
+	if err != nil {
+		return err
+	}
+
+	var countersPatterns, configPatterns []string
+	countersTables := []string{"COUNTERS_PORT_NAME_MAP", "COUNTERS_QUEUE_NAME_MAP",
+		"COUNTERS_PG_NAME_MAP", "COUNTERS_BUFFER_POOL_NAME_MAP", "COUNTERS_RIF_NAME_MAP"}
+	for _, table := range append(countersTables, defNameMapTables()...) {
+		pattern, err := keyspacePattern("COUNTERS_DB", ns, table)
 		if err != nil {
 			return err
 		}
+		countersPatterns = append(countersPatterns, pattern)
 	}
+	// PORT_QOS_MAP decides the PFC WD enabled queues as well
+	for _, table := range []string{"PORT", "PFC_WD_TABLE", "PORT_QOS_MAP", "PORTCHANNEL_MEMBER", "ACL_RULE"} {
+		pattern, err := keyspacePattern("CONFIG_DB", ns, table+separator+"*")
+		if err != nil {
+			return err
+		}
+		configPatterns = append(configPatterns, pattern)
+	}
+
+	countersSub := countersDb.PSubscribe(countersPatterns...)
+	if _, err := countersSub.Receive(); err != nil {
+		countersSub.Close()
+		return fmt.Errorf("psubscribe to %v failed: %v", countersPatterns, err)
+	}
+	configSub := configDb.PSubscribe(configPatterns...)
+	if _, err := configSub.Receive(); err != nil {
+		countersSub.Close()
+		configSub.Close()
+		return fmt.Errorf("psubscribe to %v failed: %v", configPatterns, err)
+	}
+
+	go func() {
+		countersCh := countersSub.Channel()
+		configCh := configSub.Channel()
+		var refresh <-chan time.Time
+		for {
+			select {
+			case msg := <-countersCh:
+				log.V(6).Infof("Name map change in namespace %q: %v", ns, msg)
+			case msg := <-configCh:
+				log.V(6).Infof("Name map change in namespace %q: %v", ns, msg)
+			case <-refresh:
+				refresh = nil
+				if err := refreshCountersNameMap(ns); err != nil {
+					// Retry later, in case redis is busy or restarting
+					log.V(1).Infof("%v, retry in %v", err, nameMapRefreshDelay)
+					break
+				}
+				continue
+			}
+			if refresh == nil {
+				refresh = time.After(nameMapRefreshDelay)
+			}
+		}
+	}()
+	log.V(2).Infof("Watching name maps in namespace %q", ns)
 	return nil
 }
 
 // Get the mapping between sonic interface name and oids of their PFC-WD enabled queues in COUNTERS_DB
-func getPfcwdMap() (map[string]map[string]string, error) {
+func getPfcwdMap(ns string, queueNameMap map[string]string) (map[string]map[string]string, error) {
 	var pfcwdName_map = make(map[string]map[string]string)
 
 	dbName := "CONFIG_DB"
-	separator, _ := GetTableKeySeparator(dbName)
-	redisDb, _ := Target2RedisDb[dbName]
+	separator, _ := GetTableKeySeparator(dbName, ns)
+	redisDb, _ := Target2RedisDb[ns][dbName]
 	_, err := redisDb.Ping().Result()
 	if err != nil {
 		log.V(1).Infof("Can not connect to %v, err: %v", dbName, err)
@@ -185,17 +545,17 @@ func getPfcwdMap() (map[string]map[string]string, error) {
 		}
 	}
 
-	if len(countersQueueNameMap) == 0 {
+	if len(queueNameMap) == 0 {
 		log.V(1).Infof("COUNTERS_QUEUE_NAME_MAP is empty")
 		return nil, nil
 	}
 
 	var queue_key string
-	queue_separator, _ := GetTableKeySeparator("COUNTERS_DB")
+	queue_separator, _ := GetTableKeySeparator("COUNTERS_DB", ns)
 	for port, _ := range pfcwdName_map {
 		for _, indice := range indices {
 			queue_key = port + queue_separator + indice
-			oid, ok := countersQueueNameMap[queue_key]
+			oid, ok := queueNameMap[queue_key]
 			if !ok {
 				return nil, fmt.Errorf("key %v not exists in COUNTERS_QUEUE_NAME_MAP", queue_key)
 			}
@@ -207,14 +567,74 @@ func getPfcwdMap() (map[string]map[string]string, error) {
 	return pfcwdName_map, nil
 }
 
+// Get the mapping between PortChannel name and its member sonic interface names
+func getLagMemberMap(ns string) (map[string][]string, error) {
+	var lagMember_map = make(map[string][]string)
+
+	dbName := "CONFIG_DB"
+	separator, _ := GetTableKeySeparator(dbName, ns)
+	redisDb, _ := Target2RedisDb[ns][dbName]
+
+	keyName := fmt.Sprintf("PORTCHANNEL_MEMBER%v*", separator)
+	resp, err := redisDb.Keys(keyName).Result()
+	if err != nil {
+		log.V(1).Infof("redis get keys failed for %v, key = %v, err: %v", dbName, keyName, err)
+		return nil, err
+	}
+	for _, key := range resp {
+		// key is in format of "PORTCHANNEL_MEMBER|PortChannel0001|Ethernet0"
+		names := strings.Split(key, separator)
+		if len(names) != 3 {
+			log.V(2).Infof("Invalid PortChannel member key %v", key)
+			continue
+		}
+		lagMember_map[names[1]] = append(lagMember_map[names[1]], names[2])
+	}
+	for _, members := range lagMember_map {
+		sort.Strings(members)
+	}
+	log.V(6).Infof("lagMemberMap: %v", lagMember_map)
+	return lagMember_map, nil
+}
+
+// Get the rules of each ACL table from ACL_RULE table of CONFIG_DB
+func getAclRuleMap(ns string) (map[string][]string, error) {
+	var aclRule_map = make(map[string][]string)
+
+	dbName := "CONFIG_DB"
+	separator, _ := GetTableKeySeparator(dbName, ns)
+	redisDb, _ := Target2RedisDb[ns][dbName]
+
+	keyName := fmt.Sprintf("ACL_RULE%v*", separator)
+	resp, err := redisDb.Keys(keyName).Result()
+	if err != nil {
+		log.V(1).Infof("redis get keys failed for %v, key = %v, err: %v", dbName, keyName, err)
+		return nil, err
+	}
+	for _, key := range resp {
+		// key is in format of "ACL_RULE|DATAACL|RULE_1"
+		names := strings.Split(key, separator)
+		if len(names) != 3 {
+			log.V(2).Infof("Invalid ACL rule key %v", key)
+			continue
+		}
+		aclRule_map[names[1]] = append(aclRule_map[names[1]], names[2])
+	}
+	for _, rules := range aclRule_map {
+		sort.Strings(rules)
+	}
+	log.V(6).Infof("aclRuleMap: %v", aclRule_map)
+	return aclRule_map, nil
+}
+
 // Get the mapping between sonic interface name and vendor alias
-func getAliasMap() (map[string]string, map[string]string, error) {
+func getAliasMap(ns string) (map[string]string, map[string]string, error) {
 	var alias2name_map = make(map[string]string)
 	var name2alias_map = make(map[string]string)
 
 	dbName := "CONFIG_DB"
-	separator, _ := GetTableKeySeparator(dbName)
-	redisDb, _ := Target2RedisDb[dbName]
+	separator, _ := GetTableKeySeparator(dbName, ns)
+	redisDb, _ := Target2RedisDb[ns][dbName]
 	_, err := redisDb.Ping().Result()
 	if err != nil {
 		log.V(1).Infof("Can not connect to %v, err: %v", dbName, err)
@@ -245,12 +665,12 @@ func getAliasMap() (map[string]string, map[string]string, error) {
 }
 
 // Get the mapping between objects in counters DB, Ex. port name to oid in "COUNTERS_PORT_NAME_MAP" table.
-// Aussuming static port name to oid map in COUNTERS table
-func getCountersMap(tableName string) (map[string]string, error) {
-	redisDb, _ := Target2RedisDb["COUNTERS_DB"]
+// The maps are refreshed upon change, see watchCountersNameMap()
+func getCountersMap(tableName string, ns string) (map[string]string, error) {
+	redisDb, _ := Target2RedisDb[ns]["COUNTERS_DB"]
 	fv, err := redisDb.HGetAll(tableName).Result()
 	if err != nil {
-		log.V(2).Infof("redis HGetAll failed for COUNTERS_DB, tableName: %s", tableName)
+		log.V(2).Infof("redis HGetAll failed for COUNTERS_DB in namespace %q, tableName: %s", ns, tableName)
 		return nil, err
 	}
 	log.V(6).Infof("tableName: %s, map %v", tableName, fv)
@@ -259,13 +679,13 @@ func getCountersMap(tableName string) (map[string]string, error) {
 
 // Populate real data paths from paths like
 // [COUNTER_DB COUNTERS Ethernet*] or [COUNTER_DB COUNTERS Ethernet68]
-func v2rEthPortStats(paths []string) ([]tablePath, error) {
-	separator, _ := GetTableKeySeparator(paths[DbIdx])
+func v2rEthPortStats(paths []string, ns string) ([]tablePath, error) {
+	separator, _ := GetTableKeySeparator(paths[DbIdx], ns)
 	var tblPaths []tablePath
 	if strings.HasSuffix(paths[KeyIdx], "*") { // All Ethernet ports
-		for port, oid := range countersPortNameMap {
+		for port, oid := range countersPortNameMap[ns] {
 			var oport string
-			if alias, ok := name2aliasMap[port]; ok {
+			if alias, ok := name2aliasMap[ns][port]; ok {
 				oport = alias
 			} else {
 				log.V(2).Infof("%v does not have a vendor alias", port)
@@ -285,10 +705,10 @@ func v2rEthPortStats(paths []string) ([]tablePath, error) {
 		var alias, name string
 		alias = paths[KeyIdx]
 		name = alias
-		if val, ok := alias2nameMap[alias]; ok {
+		if val, ok := alias2nameMap[ns][alias]; ok {
 			name = val
 		}
-		oid, ok := countersPortNameMap[name]
+		oid, ok := countersPortNameMap[ns][name]
 		if !ok {
 			return nil, fmt.Errorf("%v not a valid sonic interface. Vendor alias is %v", name, alias)
 		}
@@ -303,19 +723,33 @@ func v2rEthPortStats(paths []string) ([]tablePath, error) {
 	return tblPaths, nil
 }
 
+// Populate real data paths from paths like
+// [COUNTER_DB COUNTERS Ethernet* Rates] or [COUNTER_DB COUNTERS Ethernet68 Rates]
+// The port counters are sampled to compute rates.
+func v2rEthPortRates(paths []string, ns string) ([]tablePath, error) {
+	tblPaths, err := v2rEthPortStats(paths, ns)
+	if err != nil {
+		return nil, err
+	}
+	for i := range tblPaths {
+		tblPaths[i].rates = true
+	}
+	return tblPaths, nil
+}
+
 // Supported cases:
 // <1> port name having suffix of "*" with specific field;
 //     Ex. [COUNTER_DB COUNTERS Ethernet* SAI_PORT_STAT_PFC_0_RX_PKTS]
 // <2> exact port name with specific field.
 //     Ex. [COUNTER_DB COUNTERS Ethernet68 SAI_PORT_STAT_PFC_0_RX_PKTS]
 // case of "*" field could be covered in v2rEthPortStats()
-func v2rEthPortFieldStats(paths []string) ([]tablePath, error) {
-	separator, _ := GetTableKeySeparator(paths[DbIdx])
+func v2rEthPortFieldStats(paths []string, ns string) ([]tablePath, error) {
+	separator, _ := GetTableKeySeparator(paths[DbIdx], ns)
 	var tblPaths []tablePath
 	if strings.HasSuffix(paths[KeyIdx], "*") {
-		for port, oid := range countersPortNameMap {
+		for port, oid := range countersPortNameMap[ns] {
 			var oport string
-			if alias, ok := name2aliasMap[port]; ok {
+			if alias, ok := name2aliasMap[ns][port]; ok {
 				oport = alias
 			} else {
 				log.V(2).Infof("%v dose not have a vendor alias", port)
@@ -337,10 +771,10 @@ func v2rEthPortFieldStats(paths []string) ([]tablePath, error) {
 		var alias, name string
 		alias = paths[KeyIdx]
 		name = alias
-		if val, ok := alias2nameMap[alias]; ok {
+		if val, ok := alias2nameMap[ns][alias]; ok {
 			name = val
 		}
-		oid, ok := countersPortNameMap[name]
+		oid, ok := countersPortNameMap[ns][name]
 		if !ok {
 			return nil, fmt.Errorf(" %v not a valid sonic interface. Vendor alias is %v ", name, alias)
 		}
@@ -358,16 +792,16 @@ func v2rEthPortFieldStats(paths []string) ([]tablePath, error) {
 
 // Populate real data paths from paths like
 // [COUNTER_DB COUNTERS Ethernet* Pfcwd] or [COUNTER_DB COUNTERS Ethernet68 Pfcwd]
-func v2rEthPortPfcwdStats(paths []string) ([]tablePath, error) {
-	separator, _ := GetTableKeySeparator(paths[DbIdx])
+func v2rEthPortPfcwdStats(paths []string, ns string) ([]tablePath, error) {
+	separator, _ := GetTableKeySeparator(paths[DbIdx], ns)
 	var tblPaths []tablePath
 	if strings.HasSuffix(paths[KeyIdx], "*") { // Pfcwd on all Ethernet ports
-		for _, pfcqueues := range countersPfcwdNameMap {
+		for _, pfcqueues := range countersPfcwdNameMap[ns] {
 			for pfcque, oid := range pfcqueues {
 				// pfcque is in format of "Interface:12"
 				names := strings.Split(pfcque, separator)
 				var oname string
-				if alias, ok := name2aliasMap[names[0]]; ok {
+				if alias, ok := name2aliasMap[ns][names[0]]; ok {
 					oname = alias
 				} else {
 					log.V(2).Infof(" %v does not have a vendor alias", names[0])
@@ -387,15 +821,15 @@ func v2rEthPortPfcwdStats(paths []string) ([]tablePath, error) {
 	} else { // pfcwd counters on single port
 		alias := paths[KeyIdx]
 		name := alias
-		if val, ok := alias2nameMap[alias]; ok {
+		if val, ok := alias2nameMap[ns][alias]; ok {
 			name = val
 		}
-		_, ok := countersPortNameMap[name]
+		_, ok := countersPortNameMap[ns][name]
 		if !ok {
 			return nil, fmt.Errorf("%v not a valid SONiC interface. Vendor alias is %v", name, alias)
 		}
 
-		pfcqueues, ok := countersPfcwdNameMap[name]
+		pfcqueues, ok := countersPfcwdNameMap[ns][name]
 		if ok {
 			for pfcque, oid := range pfcqueues {
 				// pfcque is in format of Ethernet64:12
@@ -418,15 +852,15 @@ func v2rEthPortPfcwdStats(paths []string) ([]tablePath, error) {
 
 // Populate real data paths from paths like
 // [COUNTER_DB COUNTERS Ethernet* Queues] or [COUNTER_DB COUNTERS Ethernet68 Queues]
-func v2rEthPortQueStats(paths []string) ([]tablePath, error) {
-	separator, _ := GetTableKeySeparator(paths[DbIdx])
+func v2rEthPortQueStats(paths []string, ns string) ([]tablePath, error) {
+	separator, _ := GetTableKeySeparator(paths[DbIdx], ns)
 	var tblPaths []tablePath
 	if strings.HasSuffix(paths[KeyIdx], "*") { // queues on all Ethernet ports
-		for que, oid := range countersQueueNameMap {
+		for que, oid := range countersQueueNameMap[ns] {
 			// que is in format of "Internal_Ethernet:12"
 			names := strings.Split(que, separator)
 			var oname string
-			if alias, ok := name2aliasMap[names[0]]; ok {
+			if alias, ok := name2aliasMap[ns][names[0]]; ok {
 				oname = alias
 			} else {
 				log.V(2).Infof(" %v dose not have a vendor alias", names[0])
@@ -445,10 +879,10 @@ func v2rEthPortQueStats(paths []string) ([]tablePath, error) {
 	} else { //queues on single port
 		alias := paths[KeyIdx]
 		name := alias
-		if val, ok := alias2nameMap[alias]; ok {
+		if val, ok := alias2nameMap[ns][alias]; ok {
 			name = val
 		}
-		for que, oid := range countersQueueNameMap {
+		for que, oid := range countersQueueNameMap[ns] {
 			//que is in format of "Ethernet64:12"
 			names := strings.Split(que, separator)
 			if name != names[0] {
@@ -469,11 +903,310 @@ func v2rEthPortQueStats(paths []string) ([]tablePath, error) {
 	return tblPaths, nil
 }
 
-func lookupV2R(paths []string) ([]tablePath, error) {
+// getPortObjectOids returns oids of objects indexed on one or all Ethernet
+// ports like queues and priority groups, from nameMap which is in format
+// of "Ethernet64:12" to oid. The returned map is keyed by object name
+// with vendor alias of port.
+func getPortObjectOids(port string, separator string, nameMap map[string]string, ns string) (map[string]string, error) {
+	oids := make(map[string]string)
+	if strings.HasSuffix(port, "*") { // objects on all Ethernet ports
+		for obj, oid := range nameMap {
+			names := strings.Split(obj, separator)
+			if len(names) != 2 {
+				log.V(2).Infof("Invalid object name %v", obj)
+				continue
+			}
+			oname := names[0]
+			if alias, ok := name2aliasMap[ns][names[0]]; ok {
+				oname = alias
+			}
+			oids[strings.Join([]string{oname, names[1]}, separator)] = oid
+		}
+		return oids, nil
+	}
+
+	// objects on single port
+	alias := port
+	name := alias
+	if val, ok := alias2nameMap[ns][alias]; ok {
+		name = val
+	}
+	if _, ok := countersPortNameMap[ns][name]; !ok {
+		return nil, fmt.Errorf("%v not a valid SONiC interface. Vendor alias is %v", name, alias)
+	}
+	for obj, oid := range nameMap {
+		names := strings.Split(obj, separator)
+		if len(names) != 2 || names[0] != name {
+			continue
+		}
+		oids[strings.Join([]string{alias, names[1]}, separator)] = oid
+	}
+	return oids, nil
+}
+
+// Populate real data paths from paths like
+// [COUNTER_DB COUNTERS Ethernet* PriorityGroups] or [COUNTER_DB COUNTERS Ethernet68 PriorityGroups]
+func v2rEthPortPgStats(paths []string, ns string) ([]tablePath, error) {
+	separator, _ := GetTableKeySeparator(paths[DbIdx], ns)
+	oids, err := getPortObjectOids(paths[KeyIdx], separator, countersPgNameMap[ns], ns)
+	if err != nil {
+		return nil, err
+	}
+	var tblPaths []tablePath
+	for pg, oid := range oids {
+		tblPaths = append(tblPaths, tablePath{
+			dbName:       paths[DbIdx],
+			tableName:    paths[TblIdx],
+			tableKey:     oid,
+			delimitor:    separator,
+			jsonTableKey: pg,
+		})
+	}
+	log.V(6).Infof("v2rEthPortPgStats: %v", tblPaths)
+	return tblPaths, nil
+}
+
+// Populate real data paths from paths like
+// [COUNTER_DB COUNTERS Ethernet* Watermarks] or [COUNTER_DB COUNTERS Ethernet68 Watermarks]
+// Watermarks of each priority group and queue are put under "PriorityGroups"
+// and "Queues" respectively, then under name of watermark table.
+func v2rEthPortWatermarks(paths []string, ns string) ([]tablePath, error) {
+	separator, _ := GetTableKeySeparator(paths[DbIdx], ns)
+	groups := map[string]map[string]string{
+		"PriorityGroups": countersPgNameMap[ns],
+		"Queues":         countersQueueNameMap[ns],
+	}
+	var tblPaths []tablePath
+	for group, nameMap := range groups {
+		oids, err := getPortObjectOids(paths[KeyIdx], separator, nameMap, ns)
+		if err != nil {
+			return nil, err
+		}
+		for obj, oid := range oids {
+			for _, table := range watermarkTables {
+				tblPaths = append(tblPaths, tablePath{
+					dbName:        paths[DbIdx],
+					tableName:     table,
+					tableKey:      oid,
+					delimitor:     separator,
+					jsonGroup:     group,
+					jsonTableKey:  obj,
+					jsonTableName: table,
+				})
+			}
+		}
+	}
+	log.V(6).Infof("v2rEthPortWatermarks: %v", tblPaths)
+	return tblPaths, nil
+}
+
+// Populate real data paths from paths like
+// [COUNTER_DB COUNTERS BufferPools] or [COUNTER_DB COUNTERS BufferPools ingress_lossless_pool]
+func v2rBufferPoolWatermarks(paths []string, ns string) ([]tablePath, error) {
+	separator, _ := GetTableKeySeparator(paths[DbIdx], ns)
+	pools := countersBufferPoolNameMap[ns]
+	if len(paths) > int(FieldIdx) { // single buffer pool
+		oid, ok := pools[paths[FieldIdx]]
+		if !ok {
+			return nil, fmt.Errorf("%v not a valid buffer pool", paths[FieldIdx])
+		}
+		pools = map[string]string{"": oid}
+	}
+	var tblPaths []tablePath
+	for pool, oid := range pools {
+		for _, table := range watermarkTables {
+			tblPaths = append(tblPaths, tablePath{
+				dbName:        paths[DbIdx],
+				tableName:     table,
+				tableKey:      oid,
+				delimitor:     separator,
+				jsonTableKey:  pool,
+				jsonTableName: table,
+			})
+		}
+	}
+	log.V(6).Infof("v2rBufferPoolWatermarks: %v", tblPaths)
+	return tblPaths, nil
+}
+
+// matchWildcard tells whether name matches pattern, which may have suffix
+// of "*" to match all names with the prefix.
+func matchWildcard(pattern, name string) bool {
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(name, strings.TrimSuffix(pattern, "*"))
+	}
+	return pattern == name
+}
+
+// Populate real data paths from paths like
+// [COUNTER_DB COUNTERS RIF], [COUNTER_DB COUNTERS RIF Vlan*] or [COUNTER_DB COUNTERS RIF Vlan1000]
+// Router interfaces on Ethernet ports may be given and are put in json
+// data with vendor alias.
+func v2rRifStats(paths []string, ns string) ([]tablePath, error) {
+	separator, _ := GetTableKeySeparator(paths[DbIdx], ns)
+	rif := "*"
+	if len(paths) > int(FieldIdx) {
+		rif = paths[FieldIdx]
+	}
+
+	var tblPaths []tablePath
+	if strings.HasSuffix(rif, "*") { // router interfaces matching rif
+		for name, oid := range countersRifNameMap[ns] {
+			oname := name
+			if alias, ok := name2aliasMap[ns][name]; ok {
+				oname = alias
+			}
+			if !matchWildcard(rif, name) && !matchWildcard(rif, oname) {
+				continue
+			}
+			tblPaths = append(tblPaths, tablePath{
+				dbName:       paths[DbIdx],
+				tableName:    paths[TblIdx],
+				tableKey:     oid,
+				delimitor:    separator,
+				jsonTableKey: oname,
+			})
+		}
+	} else { // single router interface
+		name := rif
+		if val, ok := alias2nameMap[ns][rif]; ok {
+			name = val
+		}
+		oid, ok := countersRifNameMap[ns][name]
+		if !ok {
+			return nil, fmt.Errorf("%v not a valid router interface", rif)
+		}
+		tblPaths = []tablePath{{
+			dbName:    paths[DbIdx],
+			tableName: paths[TblIdx],
+			tableKey:  oid,
+			delimitor: separator,
+		}}
+	}
+	log.V(6).Infof("v2rRifStats: %v", tblPaths)
+	return tblPaths, nil
+}
+
+// Populate real data paths from paths like
+// [COUNTER_DB ACL DATAACL RULE_1], [COUNTER_DB ACL DATAACL] or [COUNTER_DB ACL * *]
+// Counters of ACL rules are in COUNTERS table with key of "<table>:<rule>".
+// For wildcard table, counters are put under table name in json data.
+func v2rAclRuleStats(paths []string, ns string) ([]tablePath, error) {
+	separator, _ := GetTableKeySeparator(paths[DbIdx], ns)
+	table := paths[KeyIdx]
+	rule := "*"
+	if len(paths) > int(FieldIdx) {
+		rule = paths[FieldIdx]
+	}
+	wildTable := strings.HasSuffix(table, "*")
+	wildRule := strings.HasSuffix(rule, "*")
+
+	var tblPaths []tablePath
+	for aclTable, rules := range aclRuleMap[ns] {
+		if !matchWildcard(table, aclTable) {
+			continue
+		}
+		for _, aclRule := range rules {
+			if !matchWildcard(rule, aclRule) {
+				continue
+			}
+			tblPath := tablePath{
+				dbName:    paths[DbIdx],
+				tableName: "COUNTERS",
+				tableKey:  aclTable + separator + aclRule,
+				delimitor: separator,
+			}
+			if wildTable {
+				tblPath.jsonGroup = aclTable
+			}
+			if wildTable || wildRule {
+				tblPath.jsonTableKey = aclRule
+			}
+			tblPaths = append(tblPaths, tblPath)
+		}
+	}
+	if !wildTable && !wildRule && len(tblPaths) == 0 {
+		return nil, fmt.Errorf("%v not a valid rule of ACL table %v", rule, table)
+	}
+	log.V(6).Infof("v2rAclRuleStats: %v", tblPaths)
+	return tblPaths, nil
+}
+
+// Populate real data paths from paths like
+// [COUNTER_DB COUNTERS PortChannel*] or [COUNTER_DB COUNTERS PortChannel0001]
+func v2rLagStats(paths []string, ns string) ([]tablePath, error) {
+	tblPaths, err := v2rLagMemberStats(paths, ns, "")
+	log.V(6).Infof("v2rLagStats: %v", tblPaths)
+	return tblPaths, err
+}
+
+// Populate real data paths from paths like
+// [COUNTER_DB COUNTERS PortChannel* SAI_PORT_STAT_IF_IN_OCTETS] or
+// [COUNTER_DB COUNTERS PortChannel0001 SAI_PORT_STAT_IF_IN_OCTETS]
+func v2rLagFieldStats(paths []string, ns string) ([]tablePath, error) {
+	tblPaths, err := v2rLagMemberStats(paths, ns, paths[FieldIdx])
+	log.V(6).Infof("v2rLagFieldStats: %v", tblPaths)
+	return tblPaths, err
+}
+
+// Populate real data paths of all member ports of one or all PortChannels.
+// Counters of a PortChannel are the sum of its members, whose own counters
+// are listed under "Members" too.
+func v2rLagMemberStats(paths []string, ns string, field string) ([]tablePath, error) {
+	separator, _ := GetTableKeySeparator(paths[DbIdx], ns)
+	lags := lagMemberMap[ns]
+
+	var names []string
+	wildcard := strings.HasSuffix(paths[KeyIdx], "*")
+	if wildcard { // All PortChannels
+		for lag := range lags {
+			names = append(names, lag)
+		}
+	} else { // single PortChannel
+		if _, ok := lags[paths[KeyIdx]]; !ok {
+			return nil, fmt.Errorf("%v not a valid PortChannel with member ports", paths[KeyIdx])
+		}
+		names = []string{paths[KeyIdx]}
+	}
+
+	var tblPaths []tablePath
+	for _, lag := range names {
+		var jsonTableKey string
+		if wildcard {
+			jsonTableKey = lag
+		}
+		for _, member := range lags[lag] {
+			oid, ok := countersPortNameMap[ns][member]
+			if !ok {
+				log.V(2).Infof("%v member %v not found in COUNTERS_PORT_NAME_MAP", lag, member)
+				continue
+			}
+			omember := member
+			if alias, ok := name2aliasMap[ns][member]; ok {
+				omember = alias
+			}
+			tblPaths = append(tblPaths, tablePath{
+				dbName:        paths[DbIdx],
+				tableName:     paths[TblIdx],
+				tableKey:      oid,
+				field:         field,
+				delimitor:     separator,
+				jsonTableKey:  jsonTableKey,
+				jsonField:     field,
+				jsonMemberKey: omember,
+			})
+		}
+	}
+	return tblPaths, nil
+}
+
+func lookupV2R(paths []string, ns string) ([]tablePath, error) {
+	nameMapMu.RLock()
+	defer nameMapMu.RUnlock()
 	n, ok := v2rTrie.Find(paths)
 	if ok {
 		v2rTrans := n.meta.(v2rTranslate)
-		return v2rTrans(paths)
+		return v2rTrans(paths, ns)
 	}
 	return nil, fmt.Errorf("%v not found in virtual path tree", paths)
 }
@@ -0,0 +1,248 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	log "github.com/golang/glog"
+)
+
+// Besides the ones in pathTransFuncTbl, virtual paths of the common
+// pattern, object name to oid in a name map table then to counters key,
+// may be defined in a JSON file, ex.
+//
+// {
+//   "virtual_paths": [
+//     {
+//       "path": ["COUNTERS_DB", "COUNTERS", "Tunnels", "*"],
+//       "name_map": "COUNTERS_TUNNEL_NAME_MAP"
+//     }
+//   ]
+// }
+
+// Placeholders in key and json_key templates
+const (
+	defNameHolder = "{name}"
+	defOidHolder  = "{oid}"
+)
+
+type virtualPathDef struct {
+	// Virtual path pattern, DB target first. Elements with suffix "*"
+	// match any name having the prefix.
+	Path []string `json:"path"`
+	// Index of the element in path giving the object name, the last
+	// element by default.
+	NameIndex int `json:"name_index"`
+	// Index of the element in path giving the field, none by default.
+	FieldIndex int `json:"field_index"`
+	// Table in COUNTERS_DB mapping object names to oids
+	NameMap string `json:"name_map"`
+	// Table of the real data, the second element of path by default
+	Table string `json:"table"`
+	// Template of key in table, "{oid}" by default
+	Key string `json:"key"`
+	// Whether to translate port names to vendor alias and back. For names
+	// like "Ethernet0:3", the part before the separator is translated.
+	Alias bool `json:"alias"`
+	// Template of key in json data for wildcard object name, "{name}" by
+	// default, name being translated to vendor alias if asked.
+	JsonKey string `json:"json_key"`
+}
+
+type virtualPathDefFile struct {
+	VirtualPaths []virtualPathDef `json:"virtual_paths"`
+}
+
+var (
+	// Virtual paths loaded from definition file
+	virtualPathDefs []*virtualPathDef
+
+	// Name maps of defined virtual paths, per namespace then name map table
+	defNameMaps = make(map[string]map[string]map[string]string)
+)
+
+// check validates def and fills default values
+func (def *virtualPathDef) check() error {
+	if len(def.Path) < 3 {
+		return fmt.Errorf("path %v too short", def.Path)
+	}
+	// Name maps are refreshed with the ones of COUNTERS_DB
+	if def.Path[DbIdx] != "COUNTERS_DB" {
+		return fmt.Errorf("path %v: only COUNTERS_DB is supported", def.Path)
+	}
+	if def.NameMap == "" {
+		return fmt.Errorf("path %v: name_map missing", def.Path)
+	}
+	if def.NameIndex == 0 {
+		def.NameIndex = len(def.Path) - 1
+	}
+	if def.NameIndex <= int(TblIdx) || def.NameIndex >= len(def.Path) {
+		return fmt.Errorf("path %v: invalid name_index %v", def.Path, def.NameIndex)
+	}
+	if !strings.HasSuffix(def.Path[def.NameIndex], "*") {
+		return fmt.Errorf("path %v: name element %v must have suffix \"*\"", def.Path, def.Path[def.NameIndex])
+	}
+	if def.FieldIndex != 0 {
+		if def.FieldIndex <= int(TblIdx) || def.FieldIndex >= len(def.Path) || def.FieldIndex == def.NameIndex {
+			return fmt.Errorf("path %v: invalid field_index %v", def.Path, def.FieldIndex)
+		}
+		if !strings.HasSuffix(def.Path[def.FieldIndex], "*") {
+			return fmt.Errorf("path %v: field element %v must have suffix \"*\"", def.Path, def.Path[def.FieldIndex])
+		}
+	}
+	if def.Table == "" {
+		def.Table = def.Path[TblIdx]
+	}
+	if def.Key == "" {
+		def.Key = defOidHolder
+	}
+	if def.JsonKey == "" {
+		def.JsonKey = defNameHolder
+	}
+	return nil
+}
+
+// expandDefTemplate fills name and oid into template tmpl
+func expandDefTemplate(tmpl string, name string, oid string) string {
+	return strings.NewReplacer(defNameHolder, name, defOidHolder, oid).Replace(tmpl)
+}
+
+// translateObjectName translates the port part of object name with m
+func translateObjectName(name string, separator string, m map[string]string) string {
+	names := strings.SplitN(name, separator, 2)
+	if val, ok := m[names[0]]; ok {
+		names[0] = val
+	}
+	return strings.Join(names, separator)
+}
+
+// translate populates real data paths of paths matching def
+func (def *virtualPathDef) translate(paths []string, ns string) ([]tablePath, error) {
+	separator, _ := GetTableKeySeparator(paths[DbIdx], ns)
+	nameMap := defNameMaps[ns][def.NameMap]
+	pattern := paths[def.NameIndex]
+	var field string
+	if def.FieldIndex != 0 {
+		field = paths[def.FieldIndex]
+	}
+
+	var tblPaths []tablePath
+	if strings.HasSuffix(pattern, "*") { // objects matching pattern
+		for name, oid := range nameMap {
+			oname := name
+			if def.Alias {
+				oname = translateObjectName(name, separator, name2aliasMap[ns])
+			}
+			if !matchWildcard(pattern, name) && !matchWildcard(pattern, oname) {
+				continue
+			}
+			tblPaths = append(tblPaths, tablePath{
+				dbName:       paths[DbIdx],
+				tableName:    def.Table,
+				tableKey:     expandDefTemplate(def.Key, name, oid),
+				delimitor:    separator,
+				field:        field,
+				jsonTableKey: expandDefTemplate(def.JsonKey, oname, oid),
+				jsonField:    field,
+			})
+		}
+	} else { // single object
+		name := pattern
+		if def.Alias {
+			name = translateObjectName(pattern, separator, alias2nameMap[ns])
+		}
+		oid, ok := nameMap[name]
+		if !ok {
+			return nil, fmt.Errorf("%v not found in %v", pattern, def.NameMap)
+		}
+		tblPaths = []tablePath{{
+			dbName:    paths[DbIdx],
+			tableName: def.Table,
+			tableKey:  expandDefTemplate(def.Key, name, oid),
+			delimitor: separator,
+			field:     field,
+		}}
+	}
+	log.V(6).Infof("virtual path %v: %v", def.Path, tblPaths)
+	return tblPaths, nil
+}
+
+// getDefNameMaps gets name maps of all defined virtual paths in namespace ns
+func getDefNameMaps(ns string) (map[string]map[string]string, error) {
+	maps := make(map[string]map[string]string)
+	for _, def := range virtualPathDefs {
+		if _, ok := maps[def.NameMap]; ok {
+			continue
+		}
+		m, err := getCountersMap(def.NameMap, ns)
+		if err != nil {
+			return nil, err
+		}
+		maps[def.NameMap] = m
+	}
+	return maps, nil
+}
+
+func initDefNameMaps(ns string) error {
+	if _, ok := defNameMaps[ns]; !ok {
+		m, err := getDefNameMaps(ns)
+		if err != nil {
+			return err
+		}
+		defNameMaps[ns] = m
+	}
+	return nil
+}
+
+// defNameMapTables returns name map tables of all defined virtual paths
+func defNameMapTables() []string {
+	var tables []string
+	seen := make(map[string]bool)
+	for _, def := range virtualPathDefs {
+		if !seen[def.NameMap] {
+			seen[def.NameMap] = true
+			tables = append(tables, def.NameMap)
+		}
+	}
+	return tables
+}
+
+// LoadVirtualPaths adds virtual paths defined in JSON file at path to
+// the virtual path tree. It is to be called before serving any request.
+func LoadVirtualPaths(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("Failed to read %v: %v", path, err)
+	}
+	var defFile virtualPathDefFile
+	if err = json.Unmarshal(data, &defFile); err != nil {
+		return fmt.Errorf("%v: Invalid virtual path definitions: %v", path, err)
+	}
+	// A path may not match any request path an existing one matches, as
+	// translation would depend on which of them is found first
+	loaded := NewTrie()
+	for i := range defFile.VirtualPaths {
+		def := &defFile.VirtualPaths[i]
+		if err = def.check(); err != nil {
+			return fmt.Errorf("%v: %v", path, err)
+		}
+		if v2rTrie.Overlaps(def.Path) || loaded.Overlaps(def.Path) {
+			return fmt.Errorf("%v: path %v conflicts with an existing virtual path", path, def.Path)
+		}
+		loaded.Add(def.Path, nil)
+	}
+
+	nameMapMu.Lock()
+	defer nameMapMu.Unlock()
+	for i := range defFile.VirtualPaths {
+		def := &defFile.VirtualPaths[i]
+		v2rTrie.Add(def.Path, v2rTranslate(def.translate))
+		virtualPathDefs = append(virtualPathDefs, def)
+	}
+	// Name maps of new definitions are loaded upon use
+	defNameMaps = make(map[string]map[string]map[string]string)
+	log.V(1).Infof("Loaded %v virtual paths from %v", len(defFile.VirtualPaths), path)
+	return nil
+}
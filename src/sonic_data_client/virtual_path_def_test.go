@@ -0,0 +1,97 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTrieFindWildcards(t *testing.T) {
+	trie := NewTrie()
+	trie.Add([]string{"COUNTERS", "Eth*", "Queues"}, "queues")
+	trie.Add([]string{"COUNTERS", "Ethernet*", "*"}, "field")
+	trie.Add([]string{"COUNTERS", "Ethernet0"}, "port")
+
+	tests := []struct {
+		keys []string
+		want interface{}
+	}{
+		{[]string{"COUNTERS", "Ethernet0"}, "port"},
+		// Longest wildcard prefix first
+		{[]string{"COUNTERS", "Ethernet4", "Pfcwd"}, "field"},
+		// Backtrack to a shorter prefix when the longer one has no match
+		{[]string{"COUNTERS", "Eth1"}, nil},
+		{[]string{"COUNTERS", "Eth1", "Queues"}, "queues"},
+		{[]string{"COUNTERS", "Ethernet0", "Pfcwd"}, "field"},
+		{[]string{"COUNTERS", "PortChannel1"}, nil},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 10; i++ {
+			var got interface{}
+			if n, ok := trie.Find(tt.keys); ok {
+				got = n.Meta()
+			}
+			if got != tt.want {
+				t.Fatalf("Find(%v) = %v, want %v", tt.keys, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestLoadVirtualPathsConflict(t *testing.T) {
+	dir, err := ioutil.TempDir("", "virtual_paths")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// Virtual paths loaded are removed afterwards
+	trie, defs := v2rTrie, virtualPathDefs
+	defer func() {
+		v2rTrie, virtualPathDefs = trie, defs
+		defNameMaps = make(map[string]map[string]map[string]string)
+	}()
+	v2rTrie = NewTrie()
+	v2rTrie.v2rTriePopulate()
+	virtualPathDefs = nil
+
+	load := func(paths ...string) error {
+		var defs []string
+		for _, p := range paths {
+			defs = append(defs, `{"path": `+p+`, "name_map": "COUNTERS_RIF_NAME_MAP"}`)
+		}
+		file := filepath.Join(dir, "virtual_paths.json")
+		data := `{"virtual_paths": [` + strings.Join(defs, ",") + `]}`
+		if err := ioutil.WriteFile(file, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return LoadVirtualPaths(file)
+	}
+
+	tests := []struct {
+		desc  string
+		paths []string
+	}{
+		{"same path as built-in", []string{`["COUNTERS_DB", "COUNTERS", "Ethernet*"]`}},
+		{"wildcard covering built-in", []string{`["COUNTERS_DB", "COUNTERS", "*"]`}},
+		{"shorter prefix than built-in", []string{`["COUNTERS_DB", "COUNTERS", "Eth*"]`}},
+		{"longer prefix than built-in", []string{`["COUNTERS_DB", "COUNTERS", "Ethernet1*"]`}},
+		{"overlapping each other", []string{
+			`["COUNTERS_DB", "COUNTERS", "Tunnel*"]`,
+			`["COUNTERS_DB", "COUNTERS", "Tun*"]`,
+		}},
+	}
+	for _, tt := range tests {
+		if err := load(tt.paths...); err == nil || !strings.Contains(err.Error(), "conflicts") {
+			t.Errorf("%v: got %v, want conflict", tt.desc, err)
+		}
+	}
+
+	if err := load(`["COUNTERS_DB", "COUNTERS", "Vlan*"]`); err != nil {
+		t.Fatalf("Failed to load non-overlapping path: %v", err)
+	}
+	if err := load(`["COUNTERS_DB", "COUNTERS", "Vlan1*"]`); err == nil {
+		t.Error("Loaded path overlapping a loaded virtual path")
+	}
+}
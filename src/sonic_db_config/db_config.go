@@ -0,0 +1,341 @@
+// Package dbconfig provides the SONiC redis database layout described
+// by database_config.json: redis instances, their sockets/ports and the
+// DB id and key separator of every database.
+// On multi-ASIC platforms, database_global.json includes the layout of
+// every ASIC namespace, the host namespace is named "".
+package dbconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	log "github.com/golang/glog"
+)
+
+const (
+	// Default location of the database layout on SONiC images
+	SONIC_DB_CONFIG_FILE string = "/var/run/redis/sonic-db/database_config.json"
+	// Layout of all namespaces on multi-ASIC images
+	SONIC_DB_GLOBAL_CONFIG_FILE string = "/var/run/redis/sonic-db/database_global.json"
+	// Environment variable which may point to an alternative layout file,
+	// used by testing program mostly.
+	SONIC_DB_CONFIG_ENV string = "DB_CONFIG_PATH"
+	// Name of the host (default) namespace
+	SONIC_DEFAULT_NAMESPACE string = ""
+)
+
+// Layout of images not having database_config.json, all DBs are served
+// by the single redis instance at /var/run/redis/redis.sock.
+const defaultDbConfig = `{
+    "INSTANCES": {
+        "redis": {
+            "hostname": "127.0.0.1",
+            "port": 6379,
+            "unix_socket_path": "/var/run/redis/redis.sock"
+        }
+    },
+    "DATABASES": {
+        "APPL_DB":         {"id": 0, "separator": ":", "instance": "redis"},
+        "ASIC_DB":         {"id": 1, "separator": ":", "instance": "redis"},
+        "COUNTERS_DB":     {"id": 2, "separator": ":", "instance": "redis"},
+        "LOGLEVEL_DB":     {"id": 3, "separator": ":", "instance": "redis"},
+        "CONFIG_DB":       {"id": 4, "separator": "|", "instance": "redis"},
+        "PFC_WD_DB":       {"id": 5, "separator": ":", "instance": "redis"},
+        "FLEX_COUNTER_DB": {"id": 5, "separator": ":", "instance": "redis"},
+        "STATE_DB":        {"id": 6, "separator": "|", "instance": "redis"}
+    },
+    "VERSION": "1.0"
+}`
+
+// redis server instance
+type dbInstance struct {
+	Hostname       string `json:"hostname"`
+	Port           int    `json:"port"`
+	UnixSocketPath string `json:"unix_socket_path"`
+}
+
+// one logical database in a redis instance
+type dbInfo struct {
+	Id        int    `json:"id"`
+	Separator string `json:"separator"`
+	Instance  string `json:"instance"`
+}
+
+type dbConfig struct {
+	Instances map[string]dbInstance `json:"INSTANCES"`
+	Databases map[string]dbInfo     `json:"DATABASES"`
+	Version   string                `json:"VERSION"`
+}
+
+// database_global.json refers to the layout file of each namespace
+type dbInclude struct {
+	Namespace string `json:"namespace"`
+	Include   string `json:"include"`
+}
+
+type dbGlobalConfig struct {
+	Includes []dbInclude `json:"INCLUDES"`
+	Version  string      `json:"VERSION"`
+}
+
+var (
+	mu sync.RWMutex
+	// namespace to its database layout
+	config   map[string]*dbConfig
+	fileName string
+)
+
+// parseDbConfig decodes and sanity checks the content of a layout file
+func parseDbConfig(data []byte) (*dbConfig, error) {
+	var cfg dbConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("Invalid database config: %v", err)
+	}
+	if len(cfg.Databases) == 0 {
+		return nil, fmt.Errorf("No database found in database config")
+	}
+	for dbName, db := range cfg.Databases {
+		if _, ok := cfg.Instances[db.Instance]; !ok {
+			return nil, fmt.Errorf("Database %v refers to unknown instance %v", dbName, db.Instance)
+		}
+	}
+	return &cfg, nil
+}
+
+// parseConfigFile loads the layout file at path, which is either the
+// layout of a single namespace or a global one including all namespaces.
+func parseConfigFile(path string, data []byte) (map[string]*dbConfig, error) {
+	var global dbGlobalConfig
+	if err := json.Unmarshal(data, &global); err != nil {
+		return nil, fmt.Errorf("%v: Invalid database config: %v", path, err)
+	}
+	if len(global.Includes) == 0 {
+		cfg, err := parseDbConfig(data)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", path, err)
+		}
+		return map[string]*dbConfig{SONIC_DEFAULT_NAMESPACE: cfg}, nil
+	}
+
+	cfgs := make(map[string]*dbConfig)
+	for _, inc := range global.Includes {
+		if _, ok := cfgs[inc.Namespace]; ok {
+			return nil, fmt.Errorf("%v: Duplicated namespace %q", path, inc.Namespace)
+		}
+		incPath := inc.Include
+		if !filepath.IsAbs(incPath) {
+			// Relative to the directory of global config
+			incPath = filepath.Join(filepath.Dir(path), incPath)
+		}
+		incData, err := ioutil.ReadFile(incPath)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read %v: %v", incPath, err)
+		}
+		cfg, err := parseDbConfig(incData)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", incPath, err)
+		}
+		cfgs[inc.Namespace] = cfg
+	}
+	return cfgs, nil
+}
+
+// ConfigFile returns the path of the database layout file in use.
+func ConfigFile() string {
+	if path := os.Getenv(SONIC_DB_CONFIG_ENV); path != "" {
+		return path
+	}
+	if _, err := os.Stat(SONIC_DB_GLOBAL_CONFIG_FILE); err == nil {
+		return SONIC_DB_GLOBAL_CONFIG_FILE
+	}
+	return SONIC_DB_CONFIG_FILE
+}
+
+// Init loads the database layout, once. If the layout file doesn't exist,
+// the legacy single instance layout is used.
+func Init() error {
+	mu.RLock()
+	loaded := config != nil
+	mu.RUnlock()
+	if loaded {
+		return nil
+	}
+
+	path := ConfigFile()
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		log.V(1).Infof("%v not found, using default database config", path)
+		path = ""
+		data, err = []byte(defaultDbConfig), nil
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to read %v: %v", path, err)
+	}
+	cfg, err := parseConfigFile(path, data)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	if config == nil {
+		config = cfg
+		fileName = path
+	}
+	mu.Unlock()
+	return nil
+}
+
+// SetConfigFile replaces the database layout in use with the one
+// described by file at path, either database_config.json or
+// database_global.json.
+func SetConfigFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("Failed to read %v: %v", path, err)
+	}
+	cfg, err := parseConfigFile(path, data)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	config = cfg
+	fileName = path
+	mu.Unlock()
+	log.V(1).Infof("Loaded database config from %v", path)
+	return nil
+}
+
+func getDbInfo(dbName, ns string) (dbInfo, dbInstance, error) {
+	if err := Init(); err != nil {
+		return dbInfo{}, dbInstance{}, err
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	cfg, ok := config[ns]
+	if !ok {
+		return dbInfo{}, dbInstance{}, fmt.Errorf("Namespace %q not found in database config %v", ns, fileName)
+	}
+	db, ok := cfg.Databases[dbName]
+	if !ok {
+		return dbInfo{}, dbInstance{}, fmt.Errorf("%v not found in namespace %q of database config %v", dbName, ns, fileName)
+	}
+	return db, cfg.Instances[db.Instance], nil
+}
+
+// GetDbNamespaces returns names of all namespaces, sorted. The host
+// namespace "" is the only one on single ASIC platforms.
+func GetDbNamespaces() []string {
+	if err := Init(); err != nil {
+		log.V(1).Infof("%v", err)
+		return nil
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	namespaces := make([]string, 0, len(config))
+	for ns := range config {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// IsNamespace tells whether ns is a namespace known to the layout.
+func IsNamespace(ns string) bool {
+	if err := Init(); err != nil {
+		return false
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	_, ok := config[ns]
+	return ok
+}
+
+// IsMultiNamespace tells whether the layout has any ASIC namespace.
+func IsMultiNamespace() bool {
+	return len(GetDbNamespaces()) > 1
+}
+
+// GetDbList returns names of all databases in namespace ns, sorted.
+func GetDbList(ns string) []string {
+	if err := Init(); err != nil {
+		log.V(1).Infof("%v", err)
+		return nil
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	cfg, ok := config[ns]
+	if !ok {
+		return nil
+	}
+	dbNames := make([]string, 0, len(cfg.Databases))
+	for dbName := range cfg.Databases {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+	return dbNames
+}
+
+// IsDb tells whether dbName is a database of any namespace.
+func IsDb(dbName string) bool {
+	for _, ns := range GetDbNamespaces() {
+		if _, _, err := getDbInfo(dbName, ns); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDbId returns the redis DB number of dbName in namespace ns.
+func GetDbId(dbName, ns string) (int, error) {
+	db, _, err := getDbInfo(dbName, ns)
+	if err != nil {
+		return -1, err
+	}
+	return db.Id, nil
+}
+
+// GetDbSeparator returns the table and key separator of dbName in namespace ns.
+func GetDbSeparator(dbName, ns string) (string, error) {
+	db, _, err := getDbInfo(dbName, ns)
+	if err != nil {
+		return "", err
+	}
+	return db.Separator, nil
+}
+
+// GetDbInstanceName returns name of the redis instance serving dbName in namespace ns.
+func GetDbInstanceName(dbName, ns string) (string, error) {
+	db, _, err := getDbInfo(dbName, ns)
+	if err != nil {
+		return "", err
+	}
+	return db.Instance, nil
+}
+
+// GetDbSock returns the unix socket of the redis instance serving dbName in namespace ns.
+func GetDbSock(dbName, ns string) (string, error) {
+	_, inst, err := getDbInfo(dbName, ns)
+	if err != nil {
+		return "", err
+	}
+	if inst.UnixSocketPath == "" {
+		return "", fmt.Errorf("No unix socket configured for %v in namespace %q", dbName, ns)
+	}
+	return inst.UnixSocketPath, nil
+}
+
+// GetDbTcpAddr returns "hostname:port" of the redis instance serving dbName in namespace ns.
+func GetDbTcpAddr(dbName, ns string) (string, error) {
+	_, inst, err := getDbInfo(dbName, ns)
+	if err != nil {
+		return "", err
+	}
+	return inst.Hostname + ":" + strconv.Itoa(inst.Port), nil
+}
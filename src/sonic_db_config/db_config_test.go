@@ -0,0 +1,150 @@
+package dbconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testDbConfig = `{
+    "INSTANCES": {
+        "redis": {
+            "hostname": "127.0.0.1",
+            "port": 6379,
+            "unix_socket_path": "/var/run/redis/redis.sock"
+        },
+        "redis_chassis": {
+            "hostname": "redis_chassis.server",
+            "port": 6380,
+            "unix_socket_path": "/var/run/redis-chassis/redis_chassis.sock"
+        }
+    },
+    "DATABASES": {
+        "COUNTERS_DB":     {"id": 2, "separator": ":", "instance": "redis"},
+        "CONFIG_DB":       {"id": 4, "separator": "|", "instance": "redis"},
+        "SNMP_OVERLAY_DB": {"id": 7, "separator": "|", "instance": "redis"},
+        "CHASSIS_APP_DB":  {"id": 12, "separator": "|", "instance": "redis_chassis"}
+    },
+    "VERSION": "1.0"
+}`
+
+func writeConfig(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "dbconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileName := filepath.Join(dir, "database_config.json")
+	if err = ioutil.WriteFile(fileName, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return fileName
+}
+
+func TestSetConfigFile(t *testing.T) {
+	fileName := writeConfig(t, testDbConfig)
+	defer os.RemoveAll(filepath.Dir(fileName))
+
+	if err := SetConfigFile(fileName); err != nil {
+		t.Fatalf("SetConfigFile failed: %v", err)
+	}
+
+	if dbs := GetDbList(""); len(dbs) != 4 {
+		t.Errorf("got %v databases, want 4", dbs)
+	}
+	if !IsDb("SNMP_OVERLAY_DB") || IsDb("OTHERS") {
+		t.Errorf("unexpected database list %v", GetDbList(""))
+	}
+	if id, _ := GetDbId("CHASSIS_APP_DB", ""); id != 12 {
+		t.Errorf("got CHASSIS_APP_DB id %v, want 12", id)
+	}
+	if sep, _ := GetDbSeparator("CONFIG_DB", ""); sep != "|" {
+		t.Errorf("got CONFIG_DB separator %v, want |", sep)
+	}
+	if sock, _ := GetDbSock("CHASSIS_APP_DB", ""); sock != "/var/run/redis-chassis/redis_chassis.sock" {
+		t.Errorf("got CHASSIS_APP_DB socket %v", sock)
+	}
+	if addr, _ := GetDbTcpAddr("COUNTERS_DB", ""); addr != "127.0.0.1:6379" {
+		t.Errorf("got COUNTERS_DB address %v", addr)
+	}
+	if _, err := GetDbId("APPL_DB", ""); err == nil {
+		t.Errorf("APPL_DB is not in config, expecting error")
+	}
+	if IsMultiNamespace() {
+		t.Errorf("unexpected namespaces %v", GetDbNamespaces())
+	}
+}
+
+const testDbAsicConfig = `{
+    "INSTANCES": {
+        "redis": {
+            "hostname": "127.0.0.1",
+            "port": 6379,
+            "unix_socket_path": "/var/run/redis0/redis.sock"
+        }
+    },
+    "DATABASES": {
+        "COUNTERS_DB": {"id": 2, "separator": ":", "instance": "redis"},
+        "CONFIG_DB":   {"id": 4, "separator": "|", "instance": "redis"}
+    },
+    "VERSION": "1.0"
+}`
+
+const testDbGlobalConfig = `{
+    "INCLUDES": [
+        {"include": "database_config.json"},
+        {"namespace": "asic0", "include": "asic0/database_config.json"}
+    ],
+    "VERSION": "1.0"
+}`
+
+func TestSetGlobalConfigFile(t *testing.T) {
+	fileName := writeConfig(t, testDbConfig)
+	dir := filepath.Dir(fileName)
+	defer os.RemoveAll(dir)
+	if err := os.Mkdir(filepath.Join(dir, "asic0"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "asic0", "database_config.json"), []byte(testDbAsicConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	globalName := filepath.Join(dir, "database_global.json")
+	if err := ioutil.WriteFile(globalName, []byte(testDbGlobalConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := SetConfigFile(globalName); err != nil {
+		t.Fatalf("SetConfigFile failed: %v", err)
+	}
+
+	if !IsMultiNamespace() || !IsNamespace("asic0") || IsNamespace("asic1") {
+		t.Errorf("unexpected namespaces %v", GetDbNamespaces())
+	}
+	if dbs := GetDbList("asic0"); len(dbs) != 2 {
+		t.Errorf("got %v databases in asic0, want 2", dbs)
+	}
+	if sock, _ := GetDbSock("COUNTERS_DB", "asic0"); sock != "/var/run/redis0/redis.sock" {
+		t.Errorf("got asic0 COUNTERS_DB socket %v", sock)
+	}
+	if sock, _ := GetDbSock("COUNTERS_DB", ""); sock != "/var/run/redis/redis.sock" {
+		t.Errorf("got host COUNTERS_DB socket %v", sock)
+	}
+	if _, err := GetDbId("CHASSIS_APP_DB", "asic0"); err == nil {
+		t.Errorf("CHASSIS_APP_DB is not in asic0, expecting error")
+	}
+	if !IsDb("CHASSIS_APP_DB") {
+		t.Errorf("CHASSIS_APP_DB is in host namespace")
+	}
+}
+
+func TestSetConfigFileInvalid(t *testing.T) {
+	fileName := writeConfig(t, `{"INSTANCES": {}, "DATABASES": {"APPL_DB": {"id": 0, "instance": "redis"}}}`)
+	defer os.RemoveAll(filepath.Dir(fileName))
+
+	if err := SetConfigFile(fileName); err == nil {
+		t.Errorf("expecting error for database with unknown instance")
+	}
+	if err := SetConfigFile(fileName + ".missing"); err == nil {
+		t.Errorf("expecting error for missing file")
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"google.golang.org/grpc/credentials"
 
 	gnmi "gnmi_server"
+	sdc "sonic_data_client"
+	sdcfg "sonic_db_config"
 	testcert "testdata/tls"
 )
 
@@ -24,10 +26,18 @@ var (
 	insecure          = flag.Bool("insecure", false, "Skip providing TLS cert and key, for testing only!")
 	jwtRefInt         = flag.Uint64("jwt_refresh_int", 30, "Seconds before JWT expiry the token can be refreshed.")
 	jwtValInt         = flag.Uint64("jwt_valid_int", 3600, "Seconds that JWT token is valid for.")
+	dbConfig          = flag.String("db_config", "", "SONiC database config file describing redis instances and DBs, database_config.json or database_global.json (default \""+sdcfg.SONIC_DB_CONFIG_FILE+"\")")
+	virtualPaths      = flag.String("virtual_path_config", "", "JSON file defining additional virtual paths of COUNTERS_DB. Optional.")
+	baselineDir       = flag.String("counter_baseline_dir", sdc.BaselineDir, "Directory keeping counter baselines saved by users")
+	checkpointDir     = flag.String("config_checkpoint_dir", sdc.CheckpointDir, "Directory keeping named CONFIG_DB checkpoints")
+	queueSize         = flag.Int("subscribe_queue_size", 10000, "Values queued per stream subscription for a slow client before applying slow_consumer_policy, 0 for unbounded")
+	slowPolicy        = gnmi.PolicyCoalesce
+	flattenJSON       = flag.Bool("flatten_json", false, "Send JSON values of tables and containers as one update per leaf")
 )
 
 func main() {
 	flag.Var(userAuth, "client_auth", "Client auth mode(s) - none,cert,password,jwt")
+	flag.Var(&slowPolicy, "slow_consumer_policy", "Policy for new values of a full subscription queue - coalesce,drop_oldest,disconnect")
 	flag.Parse()
 
 	switch {
@@ -37,6 +47,20 @@ func main() {
 	}
 	var certificate tls.Certificate
 	var err error
+	if *dbConfig != "" {
+		if err = sdc.LoadDbConfig(*dbConfig); err != nil {
+			log.Errorf("Failed to load database config: %v", err)
+			return
+		}
+	}
+	if *virtualPaths != "" {
+		if err = sdc.LoadVirtualPaths(*virtualPaths); err != nil {
+			log.Errorf("Failed to load virtual path definitions: %v", err)
+			return
+		}
+	}
+	sdc.BaselineDir = *baselineDir
+	sdc.CheckpointDir = *checkpointDir
 	gnmi.JwtRefreshInt = time.Duration(*jwtRefInt*uint64(time.Second))
 	gnmi.JwtValidInt = time.Duration(*jwtValInt*uint64(time.Second))
 	if *insecure {
@@ -98,6 +122,9 @@ func main() {
 	cfg := &gnmi.Config{}
 	cfg.Port = int64(*port)
 	cfg.UserAuth = userAuth
+	cfg.QueueSize = *queueSize
+	cfg.SlowConsumerPolicy = slowPolicy
+	cfg.FlattenJSON = *flattenJSON
 
 	gnmi.GenerateJwtSecretKey()
 
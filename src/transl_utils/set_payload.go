@@ -0,0 +1,335 @@
+package transl_utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"translib/ocbinds"
+)
+
+// Values of Set are sent to translib as RFC7951 JSON payloads. JSON values,
+// json_ietf_val or json_val, are sent as is. Scalar values are wrapped into
+// the JSON of their leaf, named with its module, and encoded per the type
+// of the leaf in the YANG schema of translib: 64-bit integers and
+// decimal64 as strings, other integers as numbers, empty as [null],
+// leafrefs as the leaf they refer to, ex. uint_val 9100 at .../config/mtu
+// as {"openconfig-interfaces:mtu":9100}.
+// Values of leaves not found in the schema are encoded per their kind.
+
+// Name of the root of the schema tree of translib
+const schemaRoot = "Device"
+
+// setPayload returns the payload setting t at uri
+func setPayload(uri string, t *gnmipb.TypedValue) ([]byte, error) {
+	switch v := t.GetValue().(type) {
+	case *gnmipb.TypedValue_JsonIetfVal:
+		return []byte(strings.Replace(string(v.JsonIetfVal), "\n", "", -1)), nil
+	case *gnmipb.TypedValue_JsonVal:
+		return []byte(strings.Replace(string(v.JsonVal), "\n", "", -1)), nil
+	case nil:
+		return nil, status.Errorf(codes.InvalidArgument, "Missing value for %v", uri)
+	}
+
+	names := uriElemNames(uri)
+	if len(names) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Scalar value %v for root path", t)
+	}
+	entry := schemaEntry(names)
+	val, err := scalarValue(t)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid value for %v: %v", uri, err)
+	}
+	if entry != nil {
+		if val, err = leafValue(entry, val); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid value for %v: %v", uri, err)
+		}
+	}
+	return json.Marshal(map[string]interface{}{leafName(names, entry): val})
+}
+
+// uriElemNames returns names of elements of uri, without their keys
+func uriElemNames(uri string) []string {
+	var names []string
+	var name strings.Builder
+	depth := 0
+	for _, c := range uri {
+		switch {
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		case depth > 0:
+		case c == '/':
+			if name.Len() > 0 {
+				names = append(names, name.String())
+				name.Reset()
+			}
+		default:
+			name.WriteRune(c)
+		}
+	}
+	if name.Len() > 0 {
+		names = append(names, name.String())
+	}
+	return names
+}
+
+// schemaEntry returns the schema entry of the path of names, nil if not
+// found
+func schemaEntry(names []string) *yang.Entry {
+	entry := ocbinds.SchemaTree[schemaRoot]
+	for _, name := range names {
+		if entry == nil {
+			return nil
+		}
+		if i := strings.Index(name, ":"); i >= 0 {
+			name = name[i+1:]
+		}
+		entry = childEntry(entry, name)
+	}
+	return entry
+}
+
+// childEntry returns child name of e, looking into its choices and cases
+// as their children are children of e in data trees
+func childEntry(e *yang.Entry, name string) *yang.Entry {
+	if c, ok := e.Dir[name]; ok {
+		return c
+	}
+	for _, c := range e.Dir {
+		if c.IsChoice() || c.IsCase() {
+			if cc := childEntry(c, name); cc != nil {
+				return cc
+			}
+		}
+	}
+	return nil
+}
+
+// leafName returns the JSON member name of the leaf of the path of names,
+// qualified by its module, of the schema entry if any, otherwise of the
+// last element qualified in the path
+func leafName(names []string, entry *yang.Entry) string {
+	name := names[len(names)-1]
+	if i := strings.Index(name, ":"); i >= 0 {
+		return name
+	}
+	if entry != nil {
+		if module, err := entry.InstantiatingModule(); err == nil && module != "" {
+			return module + ":" + name
+		}
+	}
+	for i := len(names) - 2; i >= 0; i-- {
+		if j := strings.Index(names[i], ":"); j >= 0 {
+			return names[i][:j] + ":" + name
+		}
+	}
+	return name
+}
+
+// scalarValue returns the value of scalar t, a slice for a leaf-list
+func scalarValue(t *gnmipb.TypedValue) (interface{}, error) {
+	switch v := t.GetValue().(type) {
+	case *gnmipb.TypedValue_StringVal:
+		return v.StringVal, nil
+	case *gnmipb.TypedValue_AsciiVal:
+		return v.AsciiVal, nil
+	case *gnmipb.TypedValue_IntVal:
+		return v.IntVal, nil
+	case *gnmipb.TypedValue_UintVal:
+		return v.UintVal, nil
+	case *gnmipb.TypedValue_BoolVal:
+		return v.BoolVal, nil
+	case *gnmipb.TypedValue_FloatVal:
+		return float64(v.FloatVal), nil
+	case *gnmipb.TypedValue_DecimalVal:
+		d := new(big.Float).SetInt64(v.DecimalVal.GetDigits())
+		d.Quo(d, new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(v.DecimalVal.GetPrecision())), nil)))
+		return json.Number(d.Text('f', int(v.DecimalVal.GetPrecision()))), nil
+	case *gnmipb.TypedValue_BytesVal:
+		return v.BytesVal, nil
+	case *gnmipb.TypedValue_LeaflistVal:
+		var vals []interface{}
+		for _, e := range v.LeaflistVal.GetElement() {
+			val, err := scalarValue(e)
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, val)
+		}
+		return vals, nil
+	}
+	return nil, fmt.Errorf("unsupported value type %T", t.GetValue())
+}
+
+// leafValue returns val encoded per the type of leaf or leaf-list entry
+func leafValue(entry *yang.Entry, val interface{}) (interface{}, error) {
+	vals, isList := val.([]interface{})
+	if !entry.IsLeafList() {
+		if isList {
+			return nil, fmt.Errorf("leaf-list value for leaf %v", entry.Name)
+		}
+		return typedValue(entry, entry.Type, val)
+	}
+	if !isList {
+		vals = []interface{}{val}
+	}
+	for i := range vals {
+		v, err := typedValue(entry, entry.Type, vals[i])
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = v
+	}
+	return vals, nil
+}
+
+// Sizes in bits of integer types encoded as JSON numbers
+var intBitSizes = map[yang.TypeKind]int{
+	yang.Yint8:   8,
+	yang.Yint16:  16,
+	yang.Yint32:  32,
+	yang.Yuint8:  8,
+	yang.Yuint16: 16,
+	yang.Yuint32: 32,
+}
+
+// typedValue returns val encoded per RFC7951 for YANG type t of leaf or
+// leaf-list entry. Leafrefs are encoded per the type of the leaf they
+// refer to, unions per the first of their types val is valid for.
+func typedValue(entry *yang.Entry, t *yang.YangType, val interface{}) (interface{}, error) {
+	if t == nil {
+		return val, nil
+	}
+	s := scalarString(val)
+	switch t.Kind {
+	case yang.Yint8, yang.Yint16, yang.Yint32:
+		if _, err := strconv.ParseInt(s, 10, intBitSizes[t.Kind]); err != nil {
+			return nil, fmt.Errorf("%v is not an integer of %v", s, t.Name)
+		}
+		return json.Number(s), nil
+	case yang.Yuint8, yang.Yuint16, yang.Yuint32:
+		if _, err := strconv.ParseUint(s, 10, intBitSizes[t.Kind]); err != nil {
+			return nil, fmt.Errorf("%v is not an unsigned integer of %v", s, t.Name)
+		}
+		return json.Number(s), nil
+	case yang.Yint64:
+		if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+			return nil, fmt.Errorf("%v is not an integer of %v", s, t.Name)
+		}
+		return s, nil
+	case yang.Yuint64:
+		if _, err := strconv.ParseUint(s, 10, 64); err != nil {
+			return nil, fmt.Errorf("%v is not an unsigned integer of %v", s, t.Name)
+		}
+		return s, nil
+	case yang.Ydecimal64:
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return nil, fmt.Errorf("%v is not a decimal of %v", s, t.Name)
+		}
+		return s, nil
+	case yang.Ybool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("%v is not a boolean", s)
+		}
+		return b, nil
+	case yang.Yempty:
+		return []interface{}{nil}, nil
+	case yang.Ybinary:
+		if _, ok := val.([]byte); ok {
+			return val, nil
+		}
+		return s, nil
+	case yang.Ystring, yang.Yenum, yang.Yidentityref, yang.YinstanceIdentifier, yang.Ybits:
+		return s, nil
+	case yang.Yleafref:
+		if target := leafrefTarget(entry, t.Path); target != nil {
+			return typedValue(target, target.Type, val)
+		}
+	case yang.Yunion:
+		if len(t.Type) == 0 {
+			break
+		}
+		for _, ut := range unionTypes(t, val) {
+			if v, err := typedValue(entry, ut, val); err == nil {
+				return v, nil
+			}
+		}
+		return nil, fmt.Errorf("%v is not valid for any type of union %v", s, t.Name)
+	}
+	return val, nil
+}
+
+// isStringKind tells whether values of type kind are any strings
+func isStringKind(kind yang.TypeKind) bool {
+	switch kind {
+	case yang.Ystring, yang.Yenum, yang.Yidentityref, yang.YinstanceIdentifier, yang.Ybits:
+		return true
+	}
+	return false
+}
+
+// unionTypes returns the types of union t to try val with, in order. As
+// a string type takes any value, types of values other than strings are
+// tried first for values other than strings, ex. uint_val 5 for a union
+// of string and uint32 is the uint32 5.
+func unionTypes(t *yang.YangType, val interface{}) []*yang.YangType {
+	if _, ok := val.(string); ok {
+		return t.Type
+	}
+	var types, stringTypes []*yang.YangType
+	for _, ut := range t.Type {
+		if isStringKind(ut.Kind) {
+			stringTypes = append(stringTypes, ut)
+		} else {
+			types = append(types, ut)
+		}
+	}
+	return append(types, stringTypes...)
+}
+
+// leafrefTarget returns the schema entry of the leaf referred to by path
+// of a leafref of entry, nil if not found. Predicates of path are ignored.
+func leafrefTarget(entry *yang.Entry, path string) *yang.Entry {
+	names := uriElemNames(path)
+	if strings.HasPrefix(strings.TrimSpace(path), "/") {
+		return schemaEntry(names)
+	}
+	for _, name := range names {
+		if entry == nil {
+			return nil
+		}
+		if name == ".." {
+			// Choices and cases are not nodes of data trees
+			entry = entry.Parent
+			for entry != nil && (entry.IsChoice() || entry.IsCase()) {
+				entry = entry.Parent
+			}
+			continue
+		}
+		if i := strings.Index(name, ":"); i >= 0 {
+			name = name[i+1:]
+		}
+		entry = childEntry(entry, strings.TrimSpace(name))
+	}
+	return entry
+}
+
+// scalarString returns the string of scalar val
+func scalarString(val interface{}) string {
+	switch v := val.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(val)
+}
@@ -0,0 +1,132 @@
+package transl_utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cvl"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"translib/tlerr"
+)
+
+// Errors of translib are returned as gRPC status errors, with the code of
+// their type and an ErrorInfo detail of domain "translib" giving the type
+// as reason, and the URI, path and app tag of the error as metadata. CVL
+// validation failures also have a BadRequest detail with the constraint
+// violated.
+
+// Domain of ErrorInfo details of translib errors
+const errorDomain = "translib"
+
+// TranslErrorStatus returns the status error of err of translib for
+// operation op on uri
+func TranslErrorStatus(op string, uri string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	info := &errdetails.ErrorInfo{
+		Domain:   errorDomain,
+		Metadata: map[string]string{"uri": uri},
+	}
+	var code codes.Code
+	var path, appTag string
+	var details []proto.Message
+	msg := err.Error()
+
+	switch e := err.(type) {
+	case tlerr.NotFoundError:
+		code, info.Reason, path, appTag = codes.NotFound, "NOT_FOUND", e.Path, e.AppTag
+	case tlerr.TranslibRedisClientEntryNotExist:
+		code, info.Reason = codes.NotFound, "NOT_FOUND"
+		msg = fmt.Sprintf("Entry %v not found", e.Entry)
+	case tlerr.InvalidArgsError:
+		code, info.Reason, path, appTag = codes.InvalidArgument, "INVALID_ARGUMENT", e.Path, e.AppTag
+	case tlerr.TranslibSyntaxValidationError:
+		code, info.Reason = codes.InvalidArgument, "SYNTAX_ERROR"
+		if e.ErrorStr != nil {
+			msg = e.ErrorStr.Error()
+		}
+	case tlerr.AlreadyExistsError:
+		code, info.Reason, path, appTag = codes.AlreadyExists, "ALREADY_EXISTS", e.Path, e.AppTag
+	case tlerr.NotSupportedError:
+		code, info.Reason, path, appTag = codes.Unimplemented, "NOT_SUPPORTED", e.Path, e.AppTag
+	case tlerr.AuthorizationError:
+		code, info.Reason, path, appTag = codes.PermissionDenied, "AUTHORIZATION_FAILED", e.Path, e.AppTag
+	case tlerr.InternalError:
+		code, info.Reason, path, appTag = codes.Internal, "INTERNAL", e.Path, e.AppTag
+	case tlerr.TranslibTransactionFail:
+		code, info.Reason = codes.Aborted, "TRANSACTION_CONFLICT"
+		msg = "Transaction failed on a concurrent change, retry"
+	case tlerr.TranslibDBConnectionReset:
+		code, info.Reason = codes.Unavailable, "DB_CONNECTION_RESET"
+		msg = "Database connection reset"
+	case tlerr.TranslibCVLFailure:
+		var violation *errdetails.BadRequest_FieldViolation
+		code, msg, violation = cvlFailure(e.CVLErrorInfo)
+		info.Reason = "CVL_VALIDATION_FAILED"
+		info.Metadata["cvl_code"] = strconv.Itoa(int(e.CVLErrorInfo.ErrCode))
+		info.Metadata["table"] = e.CVLErrorInfo.TableName
+		appTag = e.CVLErrorInfo.ErrAppTag
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{violation},
+		})
+	default:
+		code, info.Reason = codes.Unknown, "UNKNOWN"
+	}
+	if path != "" {
+		info.Metadata["path"] = path
+	}
+	if appTag != "" {
+		info.Metadata["app_tag"] = appTag
+	}
+	if msg == "" {
+		msg = info.Reason
+	}
+
+	st := status.Newf(code, "%s failed for %s: %s", op, uri, msg)
+	if dst, err := st.WithDetails(append([]proto.Message{info}, details...)...); err == nil {
+		st = dst
+	}
+	return st.Err()
+}
+
+// cvlFailure returns the code and message of CVL validation failure info,
+// and the violation of the constraint
+func cvlFailure(info cvl.CVLErrorInfo) (codes.Code, string, *errdetails.BadRequest_FieldViolation) {
+	var code codes.Code
+	switch info.ErrCode {
+	case cvl.CVL_SEMANTIC_KEY_ALREADY_EXIST, cvl.CVL_SEMANTIC_KEY_DUPLICATE:
+		code = codes.AlreadyExists
+	case cvl.CVL_SEMANTIC_KEY_NOT_EXIST:
+		code = codes.NotFound
+	case cvl.CVL_SEMANTIC_ERROR, cvl.CVL_SEMANTIC_DEPENDENT_DATA_MISSING, cvl.CVL_SEMANTIC_MANDATORY_DATA_MISSING:
+		code = codes.FailedPrecondition
+	default:
+		code = codes.InvalidArgument
+	}
+
+	msg := info.ConstraintErrMsg
+	if msg == "" {
+		msg = info.Msg
+	}
+	if msg == "" {
+		msg = info.CVLErrDetails
+	}
+
+	field := info.TableName
+	if len(info.Keys) > 0 {
+		field += "|" + strings.Join(info.Keys, "|")
+	}
+	if info.Field != "" {
+		field += "/" + info.Field
+	}
+	desc := msg
+	if info.Value != "" {
+		desc = fmt.Sprintf("%s (value %q)", msg, info.Value)
+	}
+	return code, msg, &errdetails.BadRequest_FieldViolation{Field: field, Description: desc}
+}
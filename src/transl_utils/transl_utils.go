@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"strings"
-	"fmt"
 	log "github.com/golang/glog"
 	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
 	"translib"
@@ -91,7 +90,7 @@ func TranslProcessGet(uriPath string, op *string, ctx context.Context) (*gnmipb.
 		data = resp.Payload
 	} else {
 		log.V(2).Infof("GET operation failed with error =%v", resp.ErrSrc)
-		return nil, fmt.Errorf("GET failed for this message")
+		return nil, TranslErrorStatus("GET", uriPath, err1)
 	}
 
 	dst := new(bytes.Buffer)
@@ -107,76 +106,75 @@ func TranslProcessGet(uriPath string, op *string, ctx context.Context) (*gnmipb.
 
 }
 
-/* Delete request handling. */
-func TranslProcessDelete(uri string, ctx context.Context) error {
-	var str3 string
-	payload := []byte(str3)
+/* Bulk request handling, for all operations of a gNMI SetRequest in one
+translib transaction. CVL validates their combined result, so operations
+may depend on each other, ex. create a VLAN and add members to it. */
+func TranslProcessBulk(delete []*gnmipb.Path, replace []*gnmipb.Update, update []*gnmipb.Update, prefix *gnmipb.Path, ctx context.Context) error {
 	rc, ctx := common_utils.GetContext(ctx)
-	req := translib.SetRequest{Path:uri, Payload:payload, User: translib.UserRoles{Name: rc.Auth.User, Roles: rc.Auth.Roles}}
-	if rc.Auth.AuthEnabled {
-		req.AuthEnabled = true
+	user := translib.UserRoles{Name: rc.Auth.User, Roles: rc.Auth.Roles}
+	req := translib.BulkRequest{User: user, AuthEnabled: rc.Auth.AuthEnabled}
+
+	for _, path := range delete {
+		var uri string
+		ConvertToURI(prefix, path, &uri)
+		req.DeleteRequest = append(req.DeleteRequest, translib.SetRequest{Path: uri, User: user, AuthEnabled: rc.Auth.AuthEnabled})
+	}
+	for _, u := range replace {
+		sr, err := bulkSetRequest(prefix, u, user, rc.Auth.AuthEnabled)
+		if err != nil {
+			return err
+		}
+		req.ReplaceRequest = append(req.ReplaceRequest, sr)
 	}
-	resp, err := translib.Delete(req)
-	if err != nil{
-		log.V(2).Infof("DELETE operation failed with error =%v", resp.ErrSrc)
-		return fmt.Errorf("DELETE failed for this message")
+	for _, u := range update {
+		sr, err := bulkSetRequest(prefix, u, user, rc.Auth.AuthEnabled)
+		if err != nil {
+			return err
+		}
+		req.UpdateRequest = append(req.UpdateRequest, sr)
 	}
 
+	resp, err := translib.Bulk(req)
+	if err != nil {
+		op, uri, opErr := bulkFailure(req, resp)
+		if opErr == nil {
+			opErr = err
+		}
+		log.V(2).Infof("Bulk %v operation failed for %v with error =%v", op, uri, opErr)
+		return TranslErrorStatus(op, uri, opErr)
+	}
 	return nil
 }
 
-/* Replace request handling. */
-func TranslProcessReplace(uri string, t *gnmipb.TypedValue, ctx context.Context) error {
-	/* Form the CURL request and send to client . */
-	str := string(t.GetJsonIetfVal())
-	str3 := strings.Replace(str, "\n", "", -1)
-	log.V(2).Infof("Incoming JSON body is", str)
-
-	payload := []byte(str3)
-	rc, ctx := common_utils.GetContext(ctx)
-	req := translib.SetRequest{Path:uri, Payload:payload, User: translib.UserRoles{Name: rc.Auth.User, Roles: rc.Auth.Roles}}
-	if rc.Auth.AuthEnabled {
-		req.AuthEnabled = true
-	}
-	resp, err1 := translib.Create(req)
-	if err1 != nil{
-		//If Create fails, it may be due to object already existing/can not be created
-		// such as interface, in this case use Update.
-		resp, err1 = translib.Update(req)
-	}
-	if err1 != nil{
-		log.V(2).Infof("REPLACE operation failed with error =%v", resp.ErrSrc)
-		return fmt.Errorf("REPLACE failed for this message")
+/* Set request of update u of a bulk request. */
+func bulkSetRequest(prefix *gnmipb.Path, u *gnmipb.Update, user translib.UserRoles, authEnabled bool) (translib.SetRequest, error) {
+	var uri string
+	ConvertToURI(prefix, u.GetPath(), &uri)
+	payload, err := setPayload(uri, u.GetVal())
+	if err != nil {
+		return translib.SetRequest{}, err
 	}
-
-
-	return nil
+	return translib.SetRequest{Path: uri, Payload: payload, User: user, AuthEnabled: authEnabled}, nil
 }
 
-/* Update request handling. */
-func TranslProcessUpdate(uri string, t *gnmipb.TypedValue, ctx context.Context) error {
-	/* Form the CURL request and send to client . */
-	str := string(t.GetJsonIetfVal())
-	str3 := strings.Replace(str, "\n", "", -1)
-	log.V(2).Infof("Incoming JSON body is", str)
-
-	payload := []byte(str3)
-	rc, ctx := common_utils.GetContext(ctx)
-	req := translib.SetRequest{Path:uri, Payload:payload, User: translib.UserRoles{Name: rc.Auth.User, Roles: rc.Auth.Roles}}
-	if rc.Auth.AuthEnabled {
-		req.AuthEnabled = true
+/* Find the operation failing a bulk request, the first with an error. */
+func bulkFailure(req translib.BulkRequest, resp translib.BulkResponse) (string, string, error) {
+	for i, r := range resp.DeleteResponse {
+		if r.Err != nil && i < len(req.DeleteRequest) {
+			return "DELETE", req.DeleteRequest[i].Path, r.Err
+		}
 	}
-	resp, err := translib.Create(req)
-	if err != nil{
-		//If Create fails, it may be due to object already existing/can not be created
-		// such as interface, in this case use Update.
-		resp, err = translib.Update(req)
+	for i, r := range resp.ReplaceResponse {
+		if r.Err != nil && i < len(req.ReplaceRequest) {
+			return "REPLACE", req.ReplaceRequest[i].Path, r.Err
+		}
 	}
-	if err != nil{
-		log.V(2).Infof("UPDATE operation failed with error =%v", resp.ErrSrc)
-		return fmt.Errorf("UPDATE failed for this message")
+	for i, r := range resp.UpdateResponse {
+		if r.Err != nil && i < len(req.UpdateRequest) {
+			return "UPDATE", req.UpdateRequest[i].Path, r.Err
+		}
 	}
-	return nil
+	return "SET", "/", nil
 }
 
 /* Action/rpc request handling. */
@@ -193,7 +191,7 @@ func TranslProcessAction(uri string, payload []byte, ctx context.Context) ([]byt
 
 	if err != nil{
 		log.V(2).Infof("Action operation failed with error =%v", resp.ErrSrc)
-		return nil, fmt.Errorf("Action failed for this message")
+		return nil, TranslErrorStatus("Action", uri, err)
 	}
 	return resp.Payload, nil
 }